@@ -46,3 +46,8 @@ func (e asciiEncoder) Decode(data []byte, length int) ([]byte, int, error) {
 
 	return out, length, nil
 }
+
+// Inspect returns human readable name of the encoder.
+func (e asciiEncoder) Inspect() string {
+	return "ASCII"
+}
@@ -0,0 +1,60 @@
+package encoding
+
+import (
+	"fmt"
+)
+
+var (
+	_              Encoder = (*asciiPrintableEncoder)(nil)
+	ASCIIPrintable         = &asciiPrintableEncoder{}
+)
+
+// asciiPrintableEncoder is a stricter version of the ASCII encoder. It
+// accepts only printable ASCII characters (0x20-0x7E) and rejects control
+// characters as well as bytes with the high bit set.
+type asciiPrintableEncoder struct{}
+
+func (e asciiPrintableEncoder) Encode(data []byte) ([]byte, error) {
+	if err := validatePrintableASCII(data); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(data))
+	copy(out, data)
+
+	return out, nil
+}
+
+func (e asciiPrintableEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	if err := validatePrintableASCII(data[:length]); err != nil {
+		return nil, 0, err
+	}
+
+	out := make([]byte, length)
+	copy(out, data[:length])
+
+	return out, length, nil
+}
+
+// Inspect returns human readable name of the encoder.
+func (e asciiPrintableEncoder) Inspect() string {
+	return "ASCIIPrintable"
+}
+
+func validatePrintableASCII(data []byte) error {
+	for i, b := range data {
+		if b < 0x20 || b > 0x7E {
+			return fmt.Errorf("non-printable byte 0x%02X at position %d", b, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestASCIIPrintable(t *testing.T) {
+	t.Run("Encode", func(t *testing.T) {
+		res, err := ASCIIPrintable.Encode([]byte(" John Doe~"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(" John Doe~"), res)
+
+		_, err = ASCIIPrintable.Encode([]byte("Doe\nJohn"))
+		require.EqualError(t, err, "non-printable byte 0x0A at position 3")
+
+		_, err = ASCIIPrintable.Encode([]byte("Doe\x7F"))
+		require.EqualError(t, err, "non-printable byte 0x7F at position 3")
+
+		_, err = ASCIIPrintable.Encode([]byte("Jos\xe9"))
+		require.EqualError(t, err, "non-printable byte 0xE9 at position 3")
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := ASCIIPrintable.Decode([]byte("John\x00"), 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte("John"), res)
+		require.Equal(t, 4, read)
+
+		_, _, err = ASCIIPrintable.Decode([]byte("John\x00"), 5)
+		require.EqualError(t, err, "non-printable byte 0x00 at position 4")
+
+		_, _, err = ASCIIPrintable.Decode([]byte("John"), 5)
+		require.EqualError(t, err, "not enough data to decode. expected len 5, got 4")
+
+		_, _, err = ASCIIPrintable.Decode([]byte("John"), -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+}
+
+func FuzzDecodeASCIIPrintable(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		ASCIIPrintable.Decode(data, length)
+	})
+}
@@ -0,0 +1,64 @@
+package encoding
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/moov-io/iso8583/utils"
+)
+
+var (
+	_      Encoder       = (*base64Encoder)(nil)
+	_      LengthCounter = (*base64Encoder)(nil)
+	_      WidthCounter  = (*base64Encoder)(nil)
+	Base64               = &base64Encoder{}
+)
+
+type base64Encoder struct{}
+
+// Encode converts bytes into their standard (padded) base64 representation
+// e.g. []byte{0xFF, 0x00} would be converted to []byte("/wA=")
+func (e base64Encoder) Encode(data []byte) ([]byte, error) {
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
+	base64.StdEncoding.Encode(out, data)
+
+	return out, nil
+}
+
+// Decode decodes length base64 characters and returns the decoded bytes and
+// the number of characters read e.g. []byte("/wA=") with length 4 would be
+// converted into []byte{0xFF, 0x00}.
+func (e base64Encoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if length > len(data) {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	out := make([]byte, base64.StdEncoding.DecodedLen(length))
+
+	n, err := base64.StdEncoding.Decode(out, data[:length])
+	if err != nil {
+		return nil, 0, utils.NewSafeError(err, "failed to perform base64 decoding")
+	}
+
+	return out[:n], length, nil
+}
+
+// Length returns the number of base64 characters data is encoded into, so
+// the length prefix holds the encoded length.
+func (e base64Encoder) Length(data []byte) int {
+	return base64.StdEncoding.EncodedLen(len(data))
+}
+
+// Width returns the number of bytes Decode reads for the length.
+func (e base64Encoder) Width(length int) int {
+	return length
+}
+
+// Inspect returns human readable name of the encoder.
+func (e base64Encoder) Inspect() string {
+	return "Base64"
+}
@@ -0,0 +1,60 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase64(t *testing.T) {
+	t.Run("Encode", func(t *testing.T) {
+		res, err := Base64.Encode([]byte{0xFF, 0x00})
+		require.NoError(t, err)
+		require.Equal(t, []byte("/wA="), res)
+
+		res, err = Base64.Encode([]byte("cert"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("Y2VydA=="), res)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := Base64.Decode([]byte("/wA=Y2VydA=="), 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xFF, 0x00}, res)
+		require.Equal(t, 4, read)
+
+		res, read, err = Base64.Decode([]byte("Y2VydA=="), 8)
+		require.NoError(t, err)
+		require.Equal(t, []byte("cert"), res)
+		require.Equal(t, 8, read)
+	})
+
+	t.Run("Length counts base64 characters", func(t *testing.T) {
+		var lc LengthCounter = Base64
+		require.Equal(t, 4, lc.Length([]byte{0xFF, 0x00}))
+		require.Equal(t, 8, lc.Length([]byte("cert")))
+	})
+
+	t.Run("Decode returns error for invalid base64", func(t *testing.T) {
+		_, _, err := Base64.Decode([]byte("Y2V*dA=="), 8)
+		require.EqualError(t, err, "failed to perform base64 decoding")
+
+		// length is not a whole number of base64 quantums
+		_, _, err = Base64.Decode([]byte("Y2VydA=="), 5)
+		require.EqualError(t, err, "failed to perform base64 decoding")
+	})
+
+	t.Run("Decode returns error for insufficient data", func(t *testing.T) {
+		_, _, err := Base64.Decode([]byte("Y2Vy"), 8)
+		require.EqualError(t, err, "not enough data to decode. expected len 8, got 4")
+
+		_, _, err = Base64.Decode([]byte("Y2Vy"), -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+}
+
+func FuzzDecodeBase64(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		Base64.Decode(data, length)
+	})
+}
@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	_   Encoder = (*bcdEncoder)(nil)
-	BCD         = &bcdEncoder{}
+	_   Encoder      = (*bcdEncoder)(nil)
+	_   WidthCounter = (*bcdEncoder)(nil)
+	BCD              = &bcdEncoder{}
 )
 
 type bcdEncoder struct{}
@@ -60,3 +61,14 @@ func (e *bcdEncoder) Decode(src []byte, length int) ([]byte, int, error) {
 	// e.g. 0643 => 643
 	return dst[decodedLen-length:], read, nil
 }
+
+// Width returns the number of bytes Decode reads for the length: one byte
+// per two digits.
+func (e *bcdEncoder) Width(length int) int {
+	return (length + 1) / 2
+}
+
+// Inspect returns human readable name of the encoder.
+func (e *bcdEncoder) Inspect() string {
+	return "BCD"
+}
@@ -65,3 +65,8 @@ func (berTLVEncoderTag) Decode(data []byte, length int) ([]byte, int, error) {
 	}
 	return out, read, nil
 }
+
+// Inspect returns human readable name of the encoder.
+func (berTLVEncoderTag) Inspect() string {
+	return "BerTLVTag"
+}
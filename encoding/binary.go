@@ -30,3 +30,8 @@ func (e binaryEncoder) Decode(data []byte, length int) ([]byte, int, error) {
 
 	return out[:length], length, nil
 }
+
+// Inspect returns human readable name of the encoder.
+func (e binaryEncoder) Inspect() string {
+	return "Binary"
+}
@@ -106,3 +106,8 @@ func (e *ebcdicEncoder) Decode(src []byte, length int) ([]byte, int, error) {
 
 	return dst, length, nil
 }
+
+// Inspect returns human readable name of the encoder.
+func (e *ebcdicEncoder) Inspect() string {
+	return "EBCDIC"
+}
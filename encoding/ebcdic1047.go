@@ -47,3 +47,8 @@ func (e ebcdic1047Encoder) Decode(data []byte, length int) ([]byte, int, error)
 	}
 	return out, length, nil
 }
+
+// Inspect returns human readable name of the encoder.
+func (e ebcdic1047Encoder) Inspect() string {
+	return "EBCDIC1047"
+}
@@ -0,0 +1,74 @@
+package encoding
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso8583/utils"
+	xencoding "golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	_ Encoder       = (*ebcdicTextEncoder)(nil)
+	_ LengthCounter = (*ebcdicTextEncoder)(nil)
+	_ WidthCounter  = (*ebcdicTextEncoder)(nil)
+)
+
+// EBCDICText is a non-validating encoder for text in EBCDIC IBM Code Page
+// 037. Decode converts every EBCDIC byte (including accented Latin
+// characters) into UTF-8. Encode does the reverse on a best-effort basis:
+// characters that are not present in the code page are replaced with the
+// EBCDIC SUB character instead of being rejected. Length is measured in
+// EBCDIC bytes.
+var EBCDICText = &ebcdicTextEncoder{
+	encoder: xencoding.ReplaceUnsupported(charmap.CodePage037.NewEncoder()),
+	decoder: charmap.CodePage037.NewDecoder(),
+}
+
+type ebcdicTextEncoder struct {
+	encoder *xencoding.Encoder
+	decoder *xencoding.Decoder
+}
+
+func (e ebcdicTextEncoder) Encode(data []byte) ([]byte, error) {
+	bytes, err := e.encoder.Bytes(data)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to encode EBCDIC text")
+	}
+	return bytes, nil
+}
+
+func (e ebcdicTextEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf(
+			"not enough data to decode. expected len %d, got %d", length, len(data),
+		)
+	}
+
+	out, err := e.decoder.Bytes(data[:length])
+	if err != nil {
+		return nil, 0, utils.NewSafeError(err, "failed to decode EBCDIC text")
+	}
+	return out, length, nil
+}
+
+// Length returns the number of EBCDIC bytes UTF-8 data is encoded into,
+// which is one byte per character.
+func (e ebcdicTextEncoder) Length(data []byte) int {
+	return utf8.RuneCount(data)
+}
+
+// Width returns the number of bytes Decode reads for the length.
+func (e ebcdicTextEncoder) Width(length int) int {
+	return length
+}
+
+// Inspect returns human readable name of the encoder.
+func (e ebcdicTextEncoder) Inspect() string {
+	return "EBCDICText"
+}
@@ -0,0 +1,51 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEBCDICText(t *testing.T) {
+	t.Run("Encode", func(t *testing.T) {
+		res, err := EBCDICText.Encode([]byte("José"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xD1, 0x96, 0xA2, 0x51}, res)
+
+		// characters missing in the code page are replaced
+		res, err = EBCDICText.Encode([]byte("A€"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xC1, 0x3F}, res)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := EBCDICText.Decode([]byte{0xD1, 0x96, 0xA2, 0x51, 0x40}, 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte("José"), res)
+		require.Equal(t, 4, read)
+
+		// control characters are decoded as is
+		res, read, err = EBCDICText.Decode([]byte{0x00, 0x3F}, 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("\x00\x1A"), res)
+		require.Equal(t, 2, read)
+
+		_, _, err = EBCDICText.Decode([]byte{0xD1}, 2)
+		require.EqualError(t, err, "not enough data to decode. expected len 2, got 1")
+
+		_, _, err = EBCDICText.Decode([]byte{0xD1}, -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+
+	t.Run("Length counts EBCDIC bytes", func(t *testing.T) {
+		var lc LengthCounter = EBCDICText
+		require.Equal(t, 4, lc.Length([]byte("café")))
+		require.Equal(t, 2, lc.Length([]byte("A€")))
+	})
+}
+
+func FuzzDecodeEBCDICText(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		EBCDICText.Decode(data, length)
+	})
+}
@@ -9,4 +9,30 @@ type Encoder interface {
 	// etc.). It returns the bytes representing the decoded data, the
 	// number of bytes read from the input, and any error
 	Decode([]byte, int) (data []byte, read int, err error)
+
+	// Inspect returns human readable name of the encoder. Returned value
+	// matches the name used for the encoder in JSON specs.
+	// Examples:
+	//  ASCII
+	//  EBCDIC
+	Inspect() string
+}
+
+// LengthCounter is implemented by encoders that measure the length passed to
+// Decode in units other than bytes (e.g. runes). Fields use it to put the
+// matching length into the length prefix when packing.
+type LengthCounter interface {
+	// Length returns the length of the source data in the units used by
+	// Decode.
+	Length([]byte) int
+}
+
+// WidthCounter is implemented by encoders that can tell the number of bytes
+// Decode reads for the length without looking at the data (e.g. one byte
+// per two digits for BCD). Encoders that implement neither WidthCounter nor
+// LengthCounter read exactly length bytes. Composite fields use it to skip
+// subfields that can't be decoded.
+type WidthCounter interface {
+	// Width returns the number of bytes Decode reads for the length.
+	Width(length int) int
 }
@@ -0,0 +1,40 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodersInspect(t *testing.T) {
+	tests := []struct {
+		enc  Encoder
+		name string
+	}{
+		{ASCII, "ASCII"},
+		{BCD, "BCD"},
+		{LBCD, "LBCD"},
+		{EBCDIC, "EBCDIC"},
+		{EBCDIC1047, "EBCDIC1047"},
+		{EBCDICText, "EBCDICText"},
+		{UTF16BE, "UTF16BE"},
+		{UTF16LE, "UTF16LE"},
+		{Base64, "Base64"},
+		{ASCIIPrintable, "ASCIIPrintable"},
+		{NewRuneText(), "RuneText"},
+		{Latin1, "Latin1"},
+		{ShiftJIS, "ShiftJIS"},
+		{NewSpaceForZero(ASCII, true), "SpaceForZero(ASCII)"},
+		{NewRegexValidated(ASCII, `^[0-9]+$`), "RegexValidated(ASCII)"},
+		{Binary, "Binary"},
+		{BytesToASCIIHex, "HexToASCII"},
+		{ASCIIHexToBytes, "ASCIIToHex"},
+		{BerTLVTag, "BerTLVTag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.name, tt.enc.Inspect())
+		})
+	}
+}
@@ -11,11 +11,21 @@ import (
 
 // HEX to ASCII encoder
 var (
-	_               Encoder = (*hexToASCIIEncoder)(nil)
-	BytesToASCIIHex         = &hexToASCIIEncoder{}
+	_               Encoder      = (*hexToASCIIEncoder)(nil)
+	_               WidthCounter = (*hexToASCIIEncoder)(nil)
+	BytesToASCIIHex              = &hexToASCIIEncoder{}
 )
 
-type hexToASCIIEncoder struct{}
+type hexToASCIIEncoder struct {
+	lower bool
+}
+
+// NewHex returns an encoder which converts bytes into their ASCII HEX
+// representation like BytesToASCIIHex, but emits uppercase or lowercase
+// HEX digits depending on upper. Decode accepts HEX digits in either case.
+func NewHex(upper bool) Encoder {
+	return &hexToASCIIEncoder{lower: !upper}
+}
 
 // Encode converts bytes into their ASCII representation.  On success, the
 // ASCII representation bytes are returned e.g. []byte{0x5F, 0x2A} would be
@@ -24,6 +34,10 @@ func (e hexToASCIIEncoder) Encode(data []byte) ([]byte, error) {
 	out := make([]byte, hex.EncodedLen(len(data)))
 	hex.Encode(out, data)
 
+	if e.lower {
+		return out, nil
+	}
+
 	str := string(out)
 	str = strings.ToUpper(str)
 
@@ -54,6 +68,21 @@ func (e hexToASCIIEncoder) Decode(data []byte, length int) ([]byte, int, error)
 	return out, read, nil
 }
 
+// Width returns the number of bytes Decode reads for the length: two hex
+// digits per byte.
+func (e hexToASCIIEncoder) Width(length int) int {
+	return hex.EncodedLen(length)
+}
+
+// Inspect returns human readable name of the encoder.
+func (e hexToASCIIEncoder) Inspect() string {
+	if e.lower {
+		return "HexToASCIILower"
+	}
+
+	return "HexToASCII"
+}
+
 // ASCII To HEX encoder
 var (
 	_               Encoder = (*asciiToHexEncoder)(nil)
@@ -93,3 +122,8 @@ func (e asciiToHexEncoder) Decode(data []byte, length int) ([]byte, int, error)
 
 	return []byte(strings.ToUpper(string(out))), length, nil
 }
+
+// Inspect returns human readable name of the encoder.
+func (e asciiToHexEncoder) Inspect() string {
+	return "ASCIIToHex"
+}
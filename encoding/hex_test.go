@@ -27,6 +27,37 @@ func TestHexToASCIIEncoder(t *testing.T) {
 	require.Equal(t, []byte("AABBCC"), got)
 }
 
+func TestNewHex(t *testing.T) {
+	data := []byte{0x5F, 0x2A, 0xBC}
+
+	tests := []struct {
+		upper   bool
+		encoded string
+	}{
+		{upper: true, encoded: "5F2ABC"},
+		{upper: false, encoded: "5f2abc"},
+	}
+
+	for _, tt := range tests {
+		enc := NewHex(tt.upper)
+
+		got, err := enc.Encode(data)
+		require.NoError(t, err)
+		require.Equal(t, []byte(tt.encoded), got)
+
+		decoded, read, err := enc.Decode(got, len(data))
+		require.NoError(t, err)
+		require.Equal(t, 6, read)
+		require.Equal(t, data, decoded)
+
+		// both cases are accepted on decode
+		decoded, read, err = enc.Decode([]byte("5f2ABc"), len(data))
+		require.NoError(t, err)
+		require.Equal(t, 6, read)
+		require.Equal(t, data, decoded)
+	}
+}
+
 func TestASCIIToHexEncoder(t *testing.T) {
 	enc := ASCIIHexToBytes
 
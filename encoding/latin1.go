@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso8583/utils"
+)
+
+var (
+	_      Encoder       = (*latin1Encoder)(nil)
+	_      LengthCounter = (*latin1Encoder)(nil)
+	_      WidthCounter  = (*latin1Encoder)(nil)
+	Latin1               = &latin1Encoder{}
+)
+
+// latin1Encoder converts UTF-8 text into ISO-8859-1 (Latin-1) bytes and
+// back. Every Latin-1 byte maps to the Unicode code point with the same
+// value. Length is measured in characters (Latin-1 bytes).
+type latin1Encoder struct{}
+
+func (e latin1Encoder) Encode(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if (r == utf8.RuneError && size <= 1) || r > 0xFF {
+			return nil, utils.NewSafeError(fmt.Errorf("invalid Latin-1 char: %q", r), "failed to perform Latin-1 encoding")
+		}
+
+		out = append(out, byte(r))
+		data = data[size:]
+	}
+
+	return out, nil
+}
+
+func (e latin1Encoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	out := make([]byte, 0, length)
+	for _, b := range data[:length] {
+		out = utf8.AppendRune(out, rune(b))
+	}
+
+	return out, length, nil
+}
+
+// Length returns the number of Latin-1 characters in UTF-8 data.
+func (e latin1Encoder) Length(data []byte) int {
+	return utf8.RuneCount(data)
+}
+
+// Width returns the number of bytes Decode reads for the length.
+func (e latin1Encoder) Width(length int) int {
+	return length
+}
+
+// Inspect returns human readable name of the encoder.
+func (e latin1Encoder) Inspect() string {
+	return "Latin1"
+}
@@ -0,0 +1,44 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatin1(t *testing.T) {
+	t.Run("Encode", func(t *testing.T) {
+		res, err := Latin1.Encode([]byte("Müller Ñ"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{'M', 0xFC, 'l', 'l', 'e', 'r', ' ', 0xD1}, res)
+
+		_, err = Latin1.Encode([]byte("100€"))
+		require.EqualError(t, err, "failed to perform Latin-1 encoding")
+
+		_, err = Latin1.Encode([]byte("M\xc3"))
+		require.EqualError(t, err, "failed to perform Latin-1 encoding")
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := Latin1.Decode([]byte{'J', 'o', 's', 0xE9, ' '}, 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte("José"), res)
+		require.Equal(t, 4, read)
+
+		_, _, err = Latin1.Decode([]byte{'J', 'o'}, 4)
+		require.EqualError(t, err, "not enough data to decode. expected len 4, got 2")
+
+		_, _, err = Latin1.Decode([]byte{'J', 'o'}, -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+
+	t.Run("Length counts characters", func(t *testing.T) {
+		require.Equal(t, 4, Latin1.Length([]byte("José")))
+	})
+}
+
+func FuzzDecodeLatin1(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		Latin1.Decode(data, length)
+	})
+}
@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	_    Encoder = (*lBCDEncoder)(nil)
-	LBCD         = &lBCDEncoder{}
+	_    Encoder      = (*lBCDEncoder)(nil)
+	_    WidthCounter = (*lBCDEncoder)(nil)
+	LBCD              = &lBCDEncoder{}
 )
 
 type lBCDEncoder struct{}
@@ -57,3 +58,14 @@ func (e *lBCDEncoder) Decode(src []byte, length int) ([]byte, int, error) {
 	// 0 index
 	return dst[:length], read, nil
 }
+
+// Width returns the number of bytes Decode reads for the length: one byte
+// per two digits.
+func (e *lBCDEncoder) Width(length int) int {
+	return (length + 1) / 2
+}
+
+// Inspect returns human readable name of the encoder.
+func (e *lBCDEncoder) Inspect() string {
+	return "LBCD"
+}
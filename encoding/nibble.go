@@ -0,0 +1,108 @@
+package encoding
+
+import (
+	"fmt"
+)
+
+var _ Encoder = (*nibbleMappedEncoder)(nil)
+var _ WidthCounter = (*nibbleMappedEncoder)(nil)
+
+// nibbleMappedEncoder packs decimal digits two per byte like BCD, but
+// translates every digit through a custom table of nibble values. It's used
+// by legacy terminals where, for example, digit 0 is sent as 0xA.
+type nibbleMappedEncoder struct {
+	toNibble   [10]byte
+	fromNibble [16]int
+}
+
+// NewNibbleMapped returns an encoder that packs digits into nibbles using
+// the given table: the digit d is encoded as table[d]. Like BCD, odd number
+// of digits is left padded with the nibble of digit 0. It panics if a table
+// value doesn't fit into a nibble or if values are not unique.
+func NewNibbleMapped(table [10]byte) Encoder {
+	e := &nibbleMappedEncoder{
+		toNibble: table,
+	}
+
+	for i := range e.fromNibble {
+		e.fromNibble[i] = -1
+	}
+
+	for digit, nibble := range table {
+		if nibble > 0x0F {
+			panic(fmt.Sprintf("nibble 0x%X for digit %d does not fit into 4 bits", nibble, digit))
+		}
+		if e.fromNibble[nibble] != -1 {
+			panic(fmt.Sprintf("nibble 0x%X is mapped to more than one digit", nibble))
+		}
+		e.fromNibble[nibble] = digit
+	}
+
+	return e
+}
+
+func (e *nibbleMappedEncoder) Encode(src []byte) ([]byte, error) {
+	if len(src)%2 != 0 {
+		src = append([]byte("0"), src...)
+	}
+
+	dst := make([]byte, len(src)/2)
+	for i, r := range src {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("invalid digit: '%s'", string(r))
+		}
+
+		nibble := e.toNibble[r-'0']
+		if i%2 == 0 {
+			dst[i/2] = nibble << 4
+		} else {
+			dst[i/2] |= nibble
+		}
+	}
+
+	return dst, nil
+}
+
+func (e *nibbleMappedEncoder) Decode(src []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	decodedLen := length
+	if length%2 != 0 {
+		decodedLen += 1
+	}
+
+	read := decodedLen / 2
+	if len(src) < read {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", read, len(src))
+	}
+
+	dst := make([]byte, decodedLen)
+	for i := range dst {
+		nibble := src[i/2] >> 4
+		if i%2 != 0 {
+			nibble = src[i/2] & 0x0F
+		}
+
+		digit := e.fromNibble[nibble]
+		if digit == -1 {
+			return nil, 0, fmt.Errorf("invalid nibble: 0x%X", nibble)
+		}
+		dst[i] = byte('0' + digit)
+	}
+
+	// skip the padding nibble as the digits are right aligned
+	return dst[decodedLen-length:], read, nil
+}
+
+// Width returns the number of bytes Decode reads for the length: one byte
+// per two digits.
+func (e *nibbleMappedEncoder) Width(length int) int {
+	return (length + 1) / 2
+}
+
+// Inspect returns human readable name of the encoder.
+func (e *nibbleMappedEncoder) Inspect() string {
+	return "NibbleMapped"
+}
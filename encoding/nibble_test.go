@@ -0,0 +1,73 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNibbleMapped(t *testing.T) {
+	// digit 0 is sent as 0xA, other digits are sent as is
+	enc := NewNibbleMapped([10]byte{0xA, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9})
+
+	t.Run("Encode", func(t *testing.T) {
+		res, err := enc.Encode([]byte("1020"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x1A, 0x2A}, res)
+
+		res, err = enc.Encode([]byte("102"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xA1, 0xA2}, res)
+
+		_, err = enc.Encode([]byte("12A"))
+		require.EqualError(t, err, "invalid digit: 'A'")
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := enc.Decode([]byte{0x1A, 0x2A}, 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte("1020"), res)
+		require.Equal(t, 2, read)
+
+		res, read, err = enc.Decode([]byte{0xA1, 0xA2}, 3)
+		require.NoError(t, err)
+		require.Equal(t, []byte("102"), res)
+		require.Equal(t, 2, read)
+
+		_, _, err = enc.Decode([]byte{0x1A}, 4)
+		require.EqualError(t, err, "not enough data to decode. expected len 2, got 1")
+
+		// 0x0 is not mapped to any digit
+		_, _, err = enc.Decode([]byte{0x10}, 2)
+		require.EqualError(t, err, "invalid nibble: 0x0")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		digits := []byte("9876543210")
+
+		encoded, err := enc.Encode(digits)
+		require.NoError(t, err)
+
+		decoded, read, err := enc.Decode(encoded, len(digits))
+		require.NoError(t, err)
+		require.Equal(t, digits, decoded)
+		require.Equal(t, len(encoded), read)
+	})
+
+	t.Run("panics on invalid table", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewNibbleMapped([10]byte{0x10, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9})
+		})
+		require.Panics(t, func() {
+			NewNibbleMapped([10]byte{0x1, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9})
+		})
+	})
+}
+
+func FuzzDecodeNibbleMapped(f *testing.F) {
+	enc := NewNibbleMapped([10]byte{0xA, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9})
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
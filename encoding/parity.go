@@ -0,0 +1,106 @@
+package encoding
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Parity schemes supported by the parity ASCII encoder
+const (
+	ParityEven  = "even"
+	ParityOdd   = "odd"
+	ParityMark  = "mark"
+	ParitySpace = "space"
+)
+
+var _ Encoder = (*parityASCIIEncoder)(nil)
+
+// parityASCIIEncoder encodes 7-bit ASCII characters using the high bit of
+// each byte as a parity bit.
+type parityASCIIEncoder struct {
+	scheme string
+	strict bool
+}
+
+// NewParityASCII returns an encoder for 7-bit ASCII links that use the high
+// bit of each byte as a parity bit. Encode sets the parity bit according to
+// the scheme (even, odd, mark or space) and Decode strips it without
+// checking it.
+func NewParityASCII(scheme string) (Encoder, error) {
+	return newParityASCII(scheme, false)
+}
+
+// NewStrictParityASCII returns an encoder like NewParityASCII, but Decode
+// returns an error if the parity bit of a byte doesn't match the scheme.
+func NewStrictParityASCII(scheme string) (Encoder, error) {
+	return newParityASCII(scheme, true)
+}
+
+func newParityASCII(scheme string, strict bool) (Encoder, error) {
+	switch scheme {
+	case ParityEven, ParityOdd, ParityMark, ParitySpace:
+	default:
+		return nil, fmt.Errorf("unknown parity scheme: %s", scheme)
+	}
+
+	return &parityASCIIEncoder{
+		scheme: scheme,
+		strict: strict,
+	}, nil
+}
+
+func (e parityASCIIEncoder) Encode(data []byte) ([]byte, error) {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		if b > 127 {
+			return nil, fmt.Errorf("invalid 7-bit ASCII char at position %d", i)
+		}
+		out[i] = b | e.parityBit(b)
+	}
+
+	return out, nil
+}
+
+func (e parityASCIIEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	out := make([]byte, length)
+	for i, b := range data[:length] {
+		char := b & 0x7F
+		if e.strict && b&0x80 != e.parityBit(char) {
+			return nil, 0, fmt.Errorf("parity error at position %d", i)
+		}
+		out[i] = char
+	}
+
+	return out, length, nil
+}
+
+// Inspect returns human readable name of the encoder.
+func (e parityASCIIEncoder) Inspect() string {
+	return "ParityASCII"
+}
+
+// parityBit returns the high bit value for the 7-bit character
+func (e parityASCIIEncoder) parityBit(char byte) byte {
+	switch e.scheme {
+	case ParityEven:
+		if bits.OnesCount8(char)%2 != 0 {
+			return 0x80
+		}
+	case ParityOdd:
+		if bits.OnesCount8(char)%2 == 0 {
+			return 0x80
+		}
+	case ParityMark:
+		return 0x80
+	}
+
+	return 0
+}
@@ -0,0 +1,87 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParityASCII(t *testing.T) {
+	t.Run("round trip with even parity", func(t *testing.T) {
+		enc, err := NewParityASCII(ParityEven)
+		require.NoError(t, err)
+
+		// 'A' (0x41) has two bits set, 'C' (0x43) has three bits set
+		res, err := enc.Encode([]byte("AC"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x41, 0xC3}, res)
+
+		decoded, read, err := enc.Decode(res, 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("AC"), decoded)
+		require.Equal(t, 2, read)
+	})
+
+	t.Run("Encode sets parity bit per scheme", func(t *testing.T) {
+		tests := []struct {
+			scheme string
+			want   []byte
+		}{
+			{ParityEven, []byte{0x41, 0xC3}},
+			{ParityOdd, []byte{0xC1, 0x43}},
+			{ParityMark, []byte{0xC1, 0xC3}},
+			{ParitySpace, []byte{0x41, 0x43}},
+		}
+
+		for _, tt := range tests {
+			enc, err := NewParityASCII(tt.scheme)
+			require.NoError(t, err)
+
+			res, err := enc.Encode([]byte("AC"))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, res, tt.scheme)
+		}
+	})
+
+	t.Run("Decode ignores parity errors by default", func(t *testing.T) {
+		enc, err := NewParityASCII(ParityEven)
+		require.NoError(t, err)
+
+		decoded, _, err := enc.Decode([]byte{0xC1, 0x43}, 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("AC"), decoded)
+	})
+
+	t.Run("strict Decode detects parity errors", func(t *testing.T) {
+		enc, err := NewStrictParityASCII(ParityEven)
+		require.NoError(t, err)
+
+		_, _, err = enc.Decode([]byte{0x41, 0x43}, 2)
+		require.EqualError(t, err, "parity error at position 1")
+	})
+
+	t.Run("returns errors", func(t *testing.T) {
+		_, err := NewParityASCII("none")
+		require.EqualError(t, err, "unknown parity scheme: none")
+
+		enc, err := NewParityASCII(ParityEven)
+		require.NoError(t, err)
+
+		_, err = enc.Encode([]byte{0x41, 0xC3})
+		require.EqualError(t, err, "invalid 7-bit ASCII char at position 1")
+
+		_, _, err = enc.Decode([]byte{0x41}, 2)
+		require.EqualError(t, err, "not enough data to decode. expected len 2, got 1")
+	})
+}
+
+func FuzzDecodeStrictParityASCII(f *testing.F) {
+	enc, err := NewStrictParityASCII(ParityEven)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
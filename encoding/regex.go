@@ -0,0 +1,61 @@
+package encoding
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var _ Encoder = (*regexValidatedEncoder)(nil)
+
+// regexValidatedEncoder applies inner encoder and checks that the text
+// content matches the regular expression.
+type regexValidatedEncoder struct {
+	inner   Encoder
+	pattern *regexp.Regexp
+}
+
+// NewRegexValidated returns an encoder that applies the inner encoder (e.g.
+// ASCII or EBCDIC) and returns an error if the content (the data before
+// encoding or after decoding) doesn't match the pattern. The pattern should
+// be anchored (e.g. `^\d{12}$`) to match the whole content. It panics if the
+// pattern is not a valid regular expression.
+func NewRegexValidated(inner Encoder, pattern string) Encoder {
+	return &regexValidatedEncoder{
+		inner:   inner,
+		pattern: regexp.MustCompile(pattern),
+	}
+}
+
+func (e regexValidatedEncoder) Encode(data []byte) ([]byte, error) {
+	if err := e.validate(data); err != nil {
+		return nil, err
+	}
+
+	return e.inner.Encode(data)
+}
+
+func (e regexValidatedEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	decoded, read, err := e.inner.Decode(data, length)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := e.validate(decoded); err != nil {
+		return nil, 0, err
+	}
+
+	return decoded, read, nil
+}
+
+// Inspect returns human readable name of the encoder.
+func (e regexValidatedEncoder) Inspect() string {
+	return fmt.Sprintf("RegexValidated(%s)", e.inner.Inspect())
+}
+
+func (e regexValidatedEncoder) validate(data []byte) error {
+	if !e.pattern.Match(data) {
+		return fmt.Errorf("value %q does not match pattern %s", data, e.pattern)
+	}
+
+	return nil
+}
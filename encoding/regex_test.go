@@ -0,0 +1,56 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegexValidated(t *testing.T) {
+	// retrieval reference number: 4 digits of julian date followed by 8
+	// alphanumeric characters
+	enc := NewRegexValidated(EBCDIC1047, `^[0-9]{4}[0-9A-Z]{8}$`)
+
+	rrn := []byte("3117AB123456")
+	encoded, err := EBCDIC1047.Encode(rrn)
+	require.NoError(t, err)
+
+	t.Run("Encode", func(t *testing.T) {
+		res, err := enc.Encode(rrn)
+		require.NoError(t, err)
+		require.Equal(t, encoded, res)
+
+		_, err = enc.Encode([]byte("31x7AB123456"))
+		require.EqualError(t, err, `value "31x7AB123456" does not match pattern ^[0-9]{4}[0-9A-Z]{8}$`)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := enc.Decode(encoded, 12)
+		require.NoError(t, err)
+		require.Equal(t, rrn, res)
+		require.Equal(t, 12, read)
+
+		invalid, err := EBCDIC1047.Encode([]byte("3117ab123456"))
+		require.NoError(t, err)
+
+		_, _, err = enc.Decode(invalid, 12)
+		require.EqualError(t, err, `value "3117ab123456" does not match pattern ^[0-9]{4}[0-9A-Z]{8}$`)
+
+		_, _, err = enc.Decode(encoded, 13)
+		require.EqualError(t, err, "not enough data to decode. expected len 13, got 12")
+	})
+
+	t.Run("panics on invalid pattern", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewRegexValidated(ASCII, `[`)
+		})
+	})
+}
+
+func FuzzDecodeRegexValidated(f *testing.F) {
+	enc := NewRegexValidated(ASCII, `^[0-9]{4}[0-9A-Z]{8}$`)
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
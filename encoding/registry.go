@@ -0,0 +1,76 @@
+package encoding
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Encoder{}
+)
+
+func init() {
+	for _, enc := range []Encoder{
+		ASCII,
+		ASCIIPrintable,
+		BCD,
+		LBCD,
+		EBCDIC,
+		EBCDIC1047,
+		EBCDICText,
+		Binary,
+		BytesToASCIIHex,
+		ASCIIHexToBytes,
+		BerTLVTag,
+		Base64,
+		Latin1,
+		ShiftJIS,
+		UTF16BE,
+		UTF16LE,
+		NewHex(false),
+		NewTextValidated(),
+		NewRuneText(),
+	} {
+		Register(enc.Inspect(), enc)
+	}
+
+	// short names used in spec configuration
+	Register("Text", NewTextValidated())
+	Register("Hex", BytesToASCIIHex)
+	Register("HexLower", NewHex(false))
+}
+
+// Register makes an encoder available by the provided name, so it can be
+// resolved with ByName (e.g. when a spec is built from configuration).
+// Built-in encoders are registered under the names returned by their
+// Inspect method, and some of them under short names as well ("Text",
+// "Hex" and "HexLower"). Register panics if it's called twice with the same name
+// or if enc is nil.
+func Register(name string, enc Encoder) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if enc == nil {
+		panic("encoding: Register encoder is nil")
+	}
+
+	if _, dup := registry[name]; dup {
+		panic("encoding: Register called twice for encoder " + name)
+	}
+
+	registry[name] = enc
+}
+
+// ByName returns the encoder registered under the provided name.
+func ByName(name string) (Encoder, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	enc, found := registry[name]
+	if !found {
+		return nil, fmt.Errorf("unknown encoding: %s", name)
+	}
+
+	return enc, nil
+}
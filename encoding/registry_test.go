@@ -0,0 +1,69 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestByName(t *testing.T) {
+	t.Run("returns built-in encoders", func(t *testing.T) {
+		enc, err := ByName("ASCII")
+		require.NoError(t, err)
+		require.Equal(t, ASCII, enc)
+
+		enc, err = ByName("HexToASCII")
+		require.NoError(t, err)
+		require.Equal(t, BytesToASCIIHex, enc)
+
+		enc, err = ByName("EBCDIC1047")
+		require.NoError(t, err)
+		require.Equal(t, EBCDIC1047, enc)
+	})
+
+	t.Run("returns encoders by short names", func(t *testing.T) {
+		enc, err := ByName("Text")
+		require.NoError(t, err)
+		require.Equal(t, NewTextValidated(), enc)
+
+		enc, err = ByName("Hex")
+		require.NoError(t, err)
+		require.Equal(t, BytesToASCIIHex, enc)
+
+		enc, err = ByName("HexLower")
+		require.NoError(t, err)
+		require.Equal(t, NewHex(false), enc)
+
+		enc, err = ByName("HexToASCIILower")
+		require.NoError(t, err)
+		require.Equal(t, NewHex(false), enc)
+	})
+
+	t.Run("returns error for unknown name", func(t *testing.T) {
+		_, err := ByName("Morse")
+		require.EqualError(t, err, "unknown encoding: Morse")
+	})
+
+	t.Run("returns registered custom encoder", func(t *testing.T) {
+		custom := NewNibbleMapped([10]byte{0xA, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9})
+		Register("TestNibbleMapped", custom)
+		t.Cleanup(func() {
+			registryMu.Lock()
+			defer registryMu.Unlock()
+			delete(registry, "TestNibbleMapped")
+		})
+
+		enc, err := ByName("TestNibbleMapped")
+		require.NoError(t, err)
+		require.Equal(t, custom, enc)
+	})
+
+	t.Run("Register panics on duplicate name or nil encoder", func(t *testing.T) {
+		require.Panics(t, func() {
+			Register("ASCII", Binary)
+		})
+		require.Panics(t, func() {
+			Register("Nil", nil)
+		})
+	})
+}
@@ -0,0 +1,64 @@
+package encoding
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	_ Encoder       = (*runeTextEncoder)(nil)
+	_ LengthCounter = (*runeTextEncoder)(nil)
+)
+
+// runeTextEncoder passes UTF-8 text through as is, but measures length in
+// runes (characters) instead of bytes.
+type runeTextEncoder struct{}
+
+// NewRuneText returns an encoder for UTF-8 text whose length is a number of
+// characters, e.g. for CJK display names. Decode reads exactly length runes
+// and returns the number of bytes they occupied.
+func NewRuneText() Encoder {
+	return &runeTextEncoder{}
+}
+
+func (e runeTextEncoder) Encode(data []byte) ([]byte, error) {
+	out := make([]byte, len(data))
+	copy(out, data)
+
+	return out, nil
+}
+
+func (e runeTextEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	read := 0
+	for runes := 0; runes < length; runes++ {
+		if read >= len(data) {
+			return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, runes)
+		}
+
+		r, size := utf8.DecodeRune(data[read:])
+		if r == utf8.RuneError && size <= 1 {
+			return nil, 0, fmt.Errorf("invalid UTF-8 sequence at byte %d", read)
+		}
+
+		read += size
+	}
+
+	out := make([]byte, read)
+	copy(out, data[:read])
+
+	return out, read, nil
+}
+
+// Length returns the number of runes in data.
+func (e runeTextEncoder) Length(data []byte) int {
+	return utf8.RuneCount(data)
+}
+
+// Inspect returns human readable name of the encoder.
+func (e runeTextEncoder) Inspect() string {
+	return "RuneText"
+}
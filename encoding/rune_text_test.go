@@ -0,0 +1,54 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuneText(t *testing.T) {
+	enc := NewRuneText()
+
+	t.Run("Encode", func(t *testing.T) {
+		res, err := enc.Encode([]byte("山田太郎"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("山田太郎"), res)
+	})
+
+	t.Run("Decode reads runes", func(t *testing.T) {
+		res, read, err := enc.Decode([]byte("山田太郎 Tokyo"), 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte("山田太郎"), res)
+		require.Equal(t, 12, read)
+
+		res, read, err = enc.Decode([]byte("Aé山"), 3)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Aé山"), res)
+		require.Equal(t, 6, read)
+	})
+
+	t.Run("Decode returns error", func(t *testing.T) {
+		_, _, err := enc.Decode([]byte("山田"), 3)
+		require.EqualError(t, err, "not enough data to decode. expected len 3, got 2")
+
+		_, _, err = enc.Decode([]byte("A\xe5\xb1"), 2)
+		require.EqualError(t, err, "invalid UTF-8 sequence at byte 1")
+
+		_, _, err = enc.Decode([]byte("A"), -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+
+	t.Run("Length counts runes", func(t *testing.T) {
+		lc, ok := enc.(LengthCounter)
+		require.True(t, ok)
+		require.Equal(t, 4, lc.Length([]byte("山田太郎")))
+	})
+}
+
+func FuzzDecodeRuneText(f *testing.F) {
+	enc := NewRuneText()
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
@@ -0,0 +1,78 @@
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+)
+
+var _ Encoder = (*separatedEncoder)(nil)
+
+// separatedEncoder applies inner encoder to the segments of data between
+// separator bytes. Separators are kept as is.
+type separatedEncoder struct {
+	inner Encoder
+	sep   byte
+}
+
+// NewSeparated returns an encoder for the fields with text segments
+// separated by the binary separator byte (e.g. 0x1C field separator). The
+// inner encoder (e.g. EBCDIC) is applied to each segment, while separators
+// are neither encoded nor decoded. Inner encoder must encode one character
+// into one byte, as length is measured in bytes.
+func NewSeparated(inner Encoder, sep byte) Encoder {
+	return &separatedEncoder{
+		inner: inner,
+		sep:   sep,
+	}
+}
+
+func (e separatedEncoder) Encode(data []byte) ([]byte, error) {
+	segments := bytes.Split(data, []byte{e.sep})
+
+	out := make([]byte, 0, len(data))
+	for i, segment := range segments {
+		if i > 0 {
+			out = append(out, e.sep)
+		}
+
+		encoded, err := e.inner.Encode(segment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode segment %d: %w", i, err)
+		}
+		out = append(out, encoded...)
+	}
+
+	return out, nil
+}
+
+func (e separatedEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	segments := bytes.Split(data[:length], []byte{e.sep})
+
+	out := make([]byte, 0, length)
+	for i, segment := range segments {
+		if i > 0 {
+			out = append(out, e.sep)
+		}
+
+		decoded, _, err := e.inner.Decode(segment, len(segment))
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to decode segment %d: %w", i, err)
+		}
+		out = append(out, decoded...)
+	}
+
+	return out, length, nil
+}
+
+// Inspect returns human readable name of the encoder.
+func (e separatedEncoder) Inspect() string {
+	return fmt.Sprintf("Separated(%s)", e.inner.Inspect())
+}
@@ -0,0 +1,53 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeparated(t *testing.T) {
+	// 0x1C is kept as is, while segments are EBCDIC encoded
+	enc := NewSeparated(EBCDIC1047, 0x1C)
+
+	text := []byte("AB\x1C12\x1C")
+	encoded := []byte{0xC1, 0xC2, 0x1C, 0xF1, 0xF2, 0x1C}
+
+	t.Run("Encode", func(t *testing.T) {
+		res, err := enc.Encode(text)
+		require.NoError(t, err)
+		require.Equal(t, encoded, res)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := enc.Decode(append(encoded, 0xC3), len(encoded))
+		require.NoError(t, err)
+		require.Equal(t, text, res)
+		require.Equal(t, 6, read)
+
+		_, _, err = enc.Decode(encoded, 7)
+		require.EqualError(t, err, "not enough data to decode. expected len 7, got 6")
+
+		_, _, err = enc.Decode(encoded, -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+
+	t.Run("segment errors are reported", func(t *testing.T) {
+		enc := NewSeparated(ASCII, 0x1C)
+
+		_, err := enc.Encode([]byte("AB\x1C\xFF"))
+		require.EqualError(t, err, "failed to encode segment 1: failed to perform ASCII encoding")
+	})
+
+	t.Run("Inspect", func(t *testing.T) {
+		require.Equal(t, "Separated(EBCDIC1047)", enc.Inspect())
+	})
+}
+
+func FuzzDecodeSeparated(f *testing.F) {
+	enc := NewSeparated(EBCDIC1047, 0x1C)
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
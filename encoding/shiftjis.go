@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"fmt"
+
+	"github.com/moov-io/iso8583/utils"
+	"golang.org/x/text/encoding/japanese"
+)
+
+var (
+	_        Encoder       = (*shiftJISEncoder)(nil)
+	_        LengthCounter = (*shiftJISEncoder)(nil)
+	_        WidthCounter  = (*shiftJISEncoder)(nil)
+	ShiftJIS               = &shiftJISEncoder{}
+)
+
+// shiftJISEncoder converts UTF-8 text into Shift-JIS bytes and back. Length
+// is measured in Shift-JIS bytes.
+type shiftJISEncoder struct{}
+
+func (e shiftJISEncoder) Encode(data []byte) ([]byte, error) {
+	out, err := japanese.ShiftJIS.NewEncoder().Bytes(data)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to perform Shift-JIS encoding")
+	}
+
+	return out, nil
+}
+
+func (e shiftJISEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	out, err := japanese.ShiftJIS.NewDecoder().Bytes(data[:length])
+	if err != nil {
+		return nil, 0, utils.NewSafeError(err, "failed to perform Shift-JIS decoding")
+	}
+
+	return out, length, nil
+}
+
+// Length returns the number of Shift-JIS bytes needed to encode UTF-8 data.
+// If data can't be encoded, the length of data is returned and the error is
+// reported by Encode.
+func (e shiftJISEncoder) Length(data []byte) int {
+	out, err := japanese.ShiftJIS.NewEncoder().Bytes(data)
+	if err != nil {
+		return len(data)
+	}
+
+	return len(out)
+}
+
+// Width returns the number of bytes Decode reads for the length.
+func (e shiftJISEncoder) Width(length int) int {
+	return length
+}
+
+// Inspect returns human readable name of the encoder.
+func (e shiftJISEncoder) Inspect() string {
+	return "ShiftJIS"
+}
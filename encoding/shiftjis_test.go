@@ -0,0 +1,46 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShiftJIS(t *testing.T) {
+	// "A" (ASCII), "カ" (katakana), "日本" (kanji)
+	text := []byte("Aカ日本")
+	encoded := []byte{0x41, 0x83, 0x4A, 0x93, 0xFA, 0x96, 0x7B}
+
+	t.Run("Encode", func(t *testing.T) {
+		res, err := ShiftJIS.Encode(text)
+		require.NoError(t, err)
+		require.Equal(t, encoded, res)
+
+		// "€" can't be mapped to Shift-JIS
+		_, err = ShiftJIS.Encode([]byte("A€"))
+		require.EqualError(t, err, "failed to perform Shift-JIS encoding")
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := ShiftJIS.Decode(append(encoded, 0x41), len(encoded))
+		require.NoError(t, err)
+		require.Equal(t, text, res)
+		require.Equal(t, 7, read)
+
+		_, _, err = ShiftJIS.Decode(encoded, 8)
+		require.EqualError(t, err, "not enough data to decode. expected len 8, got 7")
+
+		_, _, err = ShiftJIS.Decode(encoded, -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+
+	t.Run("Length returns number of Shift-JIS bytes", func(t *testing.T) {
+		require.Equal(t, 7, ShiftJIS.Length(text))
+	})
+}
+
+func FuzzDecodeShiftJIS(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		ShiftJIS.Decode(data, length)
+	})
+}
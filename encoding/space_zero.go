@@ -0,0 +1,63 @@
+package encoding
+
+import (
+	"fmt"
+)
+
+var _ Encoder = (*spaceForZeroEncoder)(nil)
+
+// spaceForZeroEncoder wraps a character encoder for numeric fields where
+// leading zeros are rendered as spaces.
+type spaceForZeroEncoder struct {
+	inner      Encoder
+	emitSpaces bool
+}
+
+// NewSpaceForZero returns an encoder for numeric fields where leading zeros
+// are sent as spaces, e.g. " 5" means "05". Unlike trimming, spaces are
+// positional zeros, so on decode every leading space is replaced with '0'.
+// On encode, leading zeros (except the last digit) are replaced with spaces
+// only if emitSpaces is set. The inner encoder (e.g. ASCII or EBCDIC) is
+// applied to the characters.
+func NewSpaceForZero(inner Encoder, emitSpaces bool) Encoder {
+	return &spaceForZeroEncoder{
+		inner:      inner,
+		emitSpaces: emitSpaces,
+	}
+}
+
+func (e spaceForZeroEncoder) Encode(data []byte) ([]byte, error) {
+	if e.emitSpaces && len(data) > 0 {
+		// the last digit is kept, so zero is sent as " 0" rather than "  "
+		data = replaceLeading(data[:len(data)-1], '0', ' ', data[len(data)-1:])
+	}
+
+	return e.inner.Encode(data)
+}
+
+func (e spaceForZeroEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	decoded, read, err := e.inner.Decode(data, length)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return replaceLeading(decoded, ' ', '0', nil), read, nil
+}
+
+// Inspect returns human readable name of the encoder.
+func (e spaceForZeroEncoder) Inspect() string {
+	return fmt.Sprintf("SpaceForZero(%s)", e.inner.Inspect())
+}
+
+// replaceLeading returns a copy of data with leading from bytes replaced
+// with to, followed by tail.
+func replaceLeading(data []byte, from, to byte, tail []byte) []byte {
+	out := make([]byte, 0, len(data)+len(tail))
+	out = append(out, data...)
+
+	for i := 0; i < len(out) && out[i] == from; i++ {
+		out[i] = to
+	}
+
+	return append(out, tail...)
+}
@@ -0,0 +1,73 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceForZero(t *testing.T) {
+	t.Run("Decode treats leading spaces as zeros", func(t *testing.T) {
+		enc := NewSpaceForZero(ASCII, false)
+
+		res, read, err := enc.Decode([]byte(" 5"), 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("05"), res)
+		require.Equal(t, 2, read)
+
+		res, _, err = enc.Decode([]byte("  "), 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("00"), res)
+
+		res, _, err = enc.Decode([]byte(" 105"), 4)
+		require.NoError(t, err)
+		require.Equal(t, []byte("0105"), res)
+
+		_, _, err = enc.Decode([]byte(" 5"), 3)
+		require.EqualError(t, err, "not enough data to decode. expected len 3, got 2")
+	})
+
+	t.Run("Encode keeps zeros by default", func(t *testing.T) {
+		enc := NewSpaceForZero(ASCII, false)
+
+		res, err := enc.Encode([]byte("05"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("05"), res)
+	})
+
+	t.Run("Encode emits spaces for leading zeros", func(t *testing.T) {
+		enc := NewSpaceForZero(ASCII, true)
+
+		res, err := enc.Encode([]byte("05"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(" 5"), res)
+
+		res, err = enc.Encode([]byte("000"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("  0"), res)
+
+		res, err = enc.Encode([]byte("105"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("105"), res)
+	})
+
+	t.Run("inner encoder is applied", func(t *testing.T) {
+		enc := NewSpaceForZero(EBCDIC1047, true)
+
+		res, err := enc.Encode([]byte("05"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x40, 0xF5}, res)
+
+		decoded, _, err := enc.Decode(res, 2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("05"), decoded)
+	})
+}
+
+func FuzzDecodeSpaceForZero(f *testing.F) {
+	enc := NewSpaceForZero(ASCII, true)
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+var _ Encoder = (*validatedTextEncoder)(nil)
+
+// validatedTextEncoder passes bytes through as is, but rejects data that is
+// not valid UTF-8. Length is measured in bytes.
+type validatedTextEncoder struct{}
+
+// NewTextValidated returns an encoder that keeps data unchanged and returns
+// an error if data is not a valid UTF-8 string. It prevents corrupted
+// multibyte sequences from being silently replaced when the value is
+// marshaled into JSON.
+func NewTextValidated() Encoder {
+	return &validatedTextEncoder{}
+}
+
+func (e validatedTextEncoder) Encode(data []byte) ([]byte, error) {
+	if err := validateUTF8(data); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(data))
+	copy(out, data)
+
+	return out, nil
+}
+
+func (e validatedTextEncoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf("not enough data to decode. expected len %d, got %d", length, len(data))
+	}
+
+	if err := validateUTF8(data[:length]); err != nil {
+		return nil, 0, err
+	}
+
+	out := make([]byte, length)
+	copy(out, data[:length])
+
+	return out, length, nil
+}
+
+// Inspect returns human readable name of the encoder.
+func (e validatedTextEncoder) Inspect() string {
+	return "TextValidated"
+}
+
+func validateUTF8(data []byte) error {
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size <= 1 {
+			return fmt.Errorf("invalid UTF-8 sequence at byte %d", i)
+		}
+		i += size
+	}
+
+	return nil
+}
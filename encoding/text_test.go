@@ -0,0 +1,45 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextValidated(t *testing.T) {
+	enc := NewTextValidated()
+
+	t.Run("Encode", func(t *testing.T) {
+		res, err := enc.Encode([]byte("Café 東京"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("Café 東京"), res)
+
+		_, err = enc.Encode([]byte("Caf\xc3 Paris"))
+		require.EqualError(t, err, "invalid UTF-8 sequence at byte 3")
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		res, read, err := enc.Decode([]byte("Café and more"), 5)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Café"), res)
+		require.Equal(t, 5, read)
+
+		// multibyte sequence is cut by the length
+		_, _, err = enc.Decode([]byte("Café"), 4)
+		require.EqualError(t, err, "invalid UTF-8 sequence at byte 3")
+
+		_, _, err = enc.Decode([]byte("Cafe"), 5)
+		require.EqualError(t, err, "not enough data to decode. expected len 5, got 4")
+
+		_, _, err = enc.Decode([]byte("Cafe"), -1)
+		require.EqualError(t, err, "length should be positive, got -1")
+	})
+}
+
+func FuzzDecodeTextValidated(f *testing.F) {
+	enc := NewTextValidated()
+
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		enc.Decode(data, length)
+	})
+}
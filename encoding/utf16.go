@@ -0,0 +1,97 @@
+package encoding
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/moov-io/iso8583/utils"
+	xencoding "golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+)
+
+var (
+	_ Encoder       = (*utf16Encoder)(nil)
+	_ LengthCounter = (*utf16Encoder)(nil)
+	_ WidthCounter  = (*utf16Encoder)(nil)
+
+	// UTF16BE is an encoder for UTF-16 big-endian text. Length is measured
+	// in bytes of UTF-16 data and must be even.
+	UTF16BE = newUTF16Encoder(unicode.BigEndian, "UTF16BE")
+
+	// UTF16LE is an encoder for UTF-16 little-endian text. Length is
+	// measured in bytes of UTF-16 data and must be even.
+	UTF16LE = newUTF16Encoder(unicode.LittleEndian, "UTF16LE")
+)
+
+type utf16Encoder struct {
+	encoder *xencoding.Encoder
+	decoder *xencoding.Decoder
+	name    string
+}
+
+func newUTF16Encoder(endianness unicode.Endianness, name string) *utf16Encoder {
+	enc := unicode.UTF16(endianness, unicode.IgnoreBOM)
+
+	return &utf16Encoder{
+		encoder: enc.NewEncoder(),
+		decoder: enc.NewDecoder(),
+		name:    name,
+	}
+}
+
+func (e utf16Encoder) Encode(data []byte) ([]byte, error) {
+	bytes, err := e.encoder.Bytes(data)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to encode UTF-16")
+	}
+	return bytes, nil
+}
+
+func (e utf16Encoder) Decode(data []byte, length int) ([]byte, int, error) {
+	if length < 0 {
+		return nil, 0, fmt.Errorf("length should be positive, got %d", length)
+	}
+
+	if length%2 != 0 {
+		return nil, 0, fmt.Errorf("length should be even, got %d", length)
+	}
+
+	if len(data) < length {
+		return nil, 0, fmt.Errorf(
+			"not enough data to decode. expected len %d, got %d", length, len(data),
+		)
+	}
+
+	out, err := e.decoder.Bytes(data[:length])
+	if err != nil {
+		return nil, 0, utils.NewSafeError(err, "failed to decode UTF-16")
+	}
+	return out, length, nil
+}
+
+// Length returns the number of bytes UTF-8 data takes when encoded into
+// UTF-16: two bytes per character, or four for characters outside the Basic
+// Multilingual Plane (surrogate pairs).
+func (e utf16Encoder) Length(data []byte) int {
+	length := 0
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		data = data[size:]
+
+		length += 2
+		if r > 0xFFFF {
+			length += 2
+		}
+	}
+	return length
+}
+
+// Width returns the number of bytes Decode reads for the length.
+func (e utf16Encoder) Width(length int) int {
+	return length
+}
+
+// Inspect returns human readable name of the encoder.
+func (e utf16Encoder) Inspect() string {
+	return e.name
+}
@@ -0,0 +1,57 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUTF16(t *testing.T) {
+	t.Run("BE", func(t *testing.T) {
+		res, err := UTF16BE.Encode([]byte("Aé€"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x00, 0x41, 0x00, 0xE9, 0x20, 0xAC}, res)
+
+		res, read, err := UTF16BE.Decode([]byte{0x00, 0x41, 0x00, 0xE9, 0x20, 0xAC, 0x00}, 6)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Aé€"), res)
+		require.Equal(t, 6, read)
+	})
+
+	t.Run("LE", func(t *testing.T) {
+		res, err := UTF16LE.Encode([]byte("Aé€"))
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x41, 0x00, 0xE9, 0x00, 0xAC, 0x20}, res)
+
+		res, read, err := UTF16LE.Decode([]byte{0x41, 0x00, 0xE9, 0x00, 0xAC, 0x20}, 6)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Aé€"), res)
+		require.Equal(t, 6, read)
+	})
+
+	t.Run("Decode returns error for odd length", func(t *testing.T) {
+		_, _, err := UTF16BE.Decode([]byte{0x00, 0x41, 0x00}, 3)
+		require.EqualError(t, err, "length should be even, got 3")
+	})
+
+	t.Run("Decode returns error for short buffer", func(t *testing.T) {
+		_, _, err := UTF16BE.Decode([]byte{0x00, 0x41}, 4)
+		require.EqualError(t, err, "not enough data to decode. expected len 4, got 2")
+	})
+
+	t.Run("Length counts UTF-16 bytes", func(t *testing.T) {
+		var lc LengthCounter = UTF16BE
+		require.Equal(t, 6, lc.Length([]byte("Aé€")))
+
+		// characters outside of BMP are encoded as surrogate pairs
+		res, err := UTF16BE.Encode([]byte("A😀"))
+		require.NoError(t, err)
+		require.Equal(t, len(res), lc.Length([]byte("A😀")))
+	})
+}
+
+func FuzzDecodeUTF16(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte, length int) {
+		UTF16BE.Decode(data, length)
+	})
+}
@@ -0,0 +1,336 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/iso8583/utils"
+)
+
+var _ Field = (*Amount)(nil)
+var _ json.Marshaler = (*Amount)(nil)
+var _ json.Unmarshaler = (*Amount)(nil)
+
+// AmountSign defines how the sign of an Amount field is packed.
+type AmountSign int
+
+const (
+	// AmountUnsigned packs only the digits. Negative amounts can't be
+	// packed.
+	AmountUnsigned AmountSign = iota
+	// AmountSignCD packs a leading 'C' (credit) for positive amounts and
+	// zero, and a leading 'D' (debit) for negative amounts.
+	AmountSignCD
+	// AmountSignPlusMinus packs a leading '+' for positive amounts and
+	// zero, and a leading '-' for negative amounts.
+	AmountSignPlusMinus
+)
+
+// AmountOptions defines options of the Amount field type.
+type AmountOptions struct {
+	// Scale defines the implied number of decimal places, e.g. with scale
+	// 2 the digits "000000012345" hold 123.45.
+	Scale int
+	// Sign defines whether and how the sign is packed in front of the
+	// digits. By default, amounts are unsigned.
+	Sign AmountSign
+}
+
+// Amount is a fixed width field holding a monetary amount with an implied
+// number of decimal places defined by AmountOptions.Scale. The value is kept
+// in minor units, e.g. 12345 with scale 2 is 123.45. When packed, the digits
+// are left padded with zeros to Spec.Length (which includes the sign
+// character, if any).
+type Amount struct {
+	units int64
+	spec  *Spec
+	data  *Amount
+}
+
+func NewAmount(spec *Spec) *Amount {
+	return &Amount{
+		spec: spec,
+	}
+}
+
+// NewAmountValue returns an Amount holding the given number of minor units.
+func NewAmountValue(units int64) *Amount {
+	return &Amount{
+		units: units,
+	}
+}
+
+func (f *Amount) Spec() *Spec {
+	return f.spec
+}
+
+func (f *Amount) SetSpec(spec *Spec) {
+	f.spec = spec
+}
+
+// SetBytes parses the optional sign and the digits of the amount.
+func (f *Amount) SetBytes(b []byte) error {
+	raw := string(b)
+
+	negative := false
+	if f.sign() != AmountUnsigned {
+		if len(raw) == 0 {
+			return errors.New("missing amount sign")
+		}
+
+		positiveSign, negativeSign := f.signChars()
+		switch raw[0] {
+		case positiveSign:
+		case negativeSign:
+			negative = true
+		default:
+			return fmt.Errorf("invalid amount sign %q", raw[0])
+		}
+		raw = raw[1:]
+	}
+
+	var units int64
+	if len(raw) > 0 {
+		for i := 0; i < len(raw); i++ {
+			if raw[i] < '0' || raw[i] > '9' {
+				return fmt.Errorf("invalid amount digit %q", raw[i])
+			}
+		}
+
+		val, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return utils.NewSafeError(err, "failed to convert into amount")
+		}
+		units = val
+	}
+
+	if negative {
+		units = -units
+	}
+
+	f.units = units
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
+}
+
+// Bytes returns the sign (if any) and the zero padded digits of the amount.
+func (f *Amount) Bytes() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	units := f.units
+	sign := ""
+	if f.sign() != AmountUnsigned {
+		positiveSign, negativeSign := f.signChars()
+		sign = string(positiveSign)
+		if units < 0 {
+			sign = string(negativeSign)
+		}
+	} else if units < 0 {
+		return nil, fmt.Errorf("negative amount %d requires a sign", units)
+	}
+
+	if units < 0 {
+		units = -units
+	}
+
+	digits := strconv.FormatInt(units, 10)
+
+	if f.spec != nil {
+		width := f.spec.Length - len(sign)
+		if len(digits) > width {
+			return nil, fmt.Errorf("amount %s exceeds %d digits", digits, width)
+		}
+		digits = strings.Repeat("0", width-len(digits)) + digits
+	}
+
+	return []byte(sign + digits), nil
+}
+
+func (f *Amount) String() (string, error) {
+	if f == nil {
+		return "", nil
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Value returns the amount in minor units and its scale.
+func (f *Amount) Value() (int64, int) {
+	if f == nil {
+		return 0, 0
+	}
+	return f.units, f.scale()
+}
+
+// SetValue sets the amount in minor units.
+func (f *Amount) SetValue(units int64) {
+	f.units = units
+}
+
+// Rat returns the amount as a rational number, e.g. 123.45 for 12345 minor
+// units with scale 2.
+func (f *Amount) Rat() *big.Rat {
+	if f == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).SetFrac(big.NewInt(f.units), f.scaleFactor())
+}
+
+// SetRat sets the amount from a rational number. It returns an error if the
+// number can't be represented with the scale of the field.
+func (f *Amount) SetRat(r *big.Rat) error {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(f.scaleFactor()))
+	if !scaled.IsInt() || !scaled.Num().IsInt64() {
+		return fmt.Errorf("amount %s can't be represented with scale %d", r.FloatString(f.scale()+1), f.scale())
+	}
+
+	f.units = scaled.Num().Int64()
+	return nil
+}
+
+func (f *Amount) Pack() ([]byte, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := f.spec.Enc.Encode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	return append(packedLength, packed...), nil
+}
+
+// returns number of bytes was read
+func (f *Amount) Unpack(data []byte) (int, error) {
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	raw, read, err := f.spec.Enc.Decode(data[prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return read + prefBytes, nil
+}
+
+// Unmarshal sets field value into v which should be either *Amount or
+// *big.Rat.
+func (f *Amount) Unmarshal(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case *Amount:
+		val.units = f.units
+	case *big.Rat:
+		val.Set(f.Rat())
+	default:
+		return errors.New("data does not match required *Amount or *big.Rat type")
+	}
+
+	return nil
+}
+
+// SetData sets field value from data which should be either *Amount or
+// *big.Rat.
+func (f *Amount) SetData(data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	switch val := data.(type) {
+	case *Amount:
+		f.data = val
+		if val.units != 0 {
+			f.units = val.units
+		}
+	case *big.Rat:
+		return f.SetRat(val)
+	default:
+		return errors.New("data does not match required *Amount or *big.Rat type")
+	}
+
+	return nil
+}
+
+func (f *Amount) Marshal(data interface{}) error {
+	return f.SetData(data)
+}
+
+// MarshalJSON returns the amount as a JSON number with the decimal places,
+// e.g. -123.45.
+func (f *Amount) MarshalJSON() ([]byte, error) {
+	bytes, err := json.Marshal(json.Number(f.Rat().FloatString(f.scale())))
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to JSON marshal amount to bytes")
+	}
+	return bytes, nil
+}
+
+func (f *Amount) UnmarshalJSON(b []byte) error {
+	var v json.Number
+	if err := json.Unmarshal(b, &v); err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to amount")
+	}
+
+	r, ok := new(big.Rat).SetString(v.String())
+	if !ok {
+		return fmt.Errorf("invalid amount %q", v.String())
+	}
+
+	return f.SetRat(r)
+}
+
+// options returns the amount options of the spec or zero options if they
+// are not defined.
+func (f *Amount) options() AmountOptions {
+	if f.spec == nil || f.spec.Amount == nil {
+		return AmountOptions{}
+	}
+	return *f.spec.Amount
+}
+
+func (f *Amount) scale() int {
+	return f.options().Scale
+}
+
+func (f *Amount) sign() AmountSign {
+	return f.options().Sign
+}
+
+func (f *Amount) signChars() (positive, negative byte) {
+	if f.sign() == AmountSignCD {
+		return 'C', 'D'
+	}
+	return '+', '-'
+}
+
+func (f *Amount) scaleFactor() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(f.scale())), nil)
+}
@@ -0,0 +1,148 @@
+package field
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmountField(t *testing.T) {
+	spec := &Spec{
+		Length:      12,
+		Description: "Amount, Transaction",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+		Amount:      &AmountOptions{Scale: 2},
+	}
+
+	t.Run("Pack pads digits to the fixed width", func(t *testing.T) {
+		amount := NewAmount(spec)
+		amount.SetValue(12345)
+
+		packed, err := amount.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "000000012345", string(packed))
+	})
+
+	t.Run("Unpack returns units and scale", func(t *testing.T) {
+		amount := NewAmount(spec)
+
+		read, err := amount.Unpack([]byte("000000012345"))
+		require.NoError(t, err)
+		require.Equal(t, 12, read)
+
+		units, scale := amount.Value()
+		require.Equal(t, int64(12345), units)
+		require.Equal(t, 2, scale)
+		require.Equal(t, "123.45", amount.Rat().FloatString(2))
+	})
+
+	t.Run("Pack returns error for negative unsigned amount", func(t *testing.T) {
+		amount := NewAmount(spec)
+		amount.SetValue(-1)
+
+		_, err := amount.Pack()
+		require.EqualError(t, err, "negative amount -1 requires a sign")
+	})
+
+	t.Run("Pack returns error when amount doesn't fit", func(t *testing.T) {
+		amount := NewAmount(spec)
+		amount.SetValue(1234567890123)
+
+		_, err := amount.Pack()
+		require.EqualError(t, err, "amount 1234567890123 exceeds 12 digits")
+	})
+
+	t.Run("Unpack returns error for non digits", func(t *testing.T) {
+		amount := NewAmount(spec)
+
+		_, err := amount.Unpack([]byte("00000001234+"))
+		require.EqualError(t, err, "failed to set bytes: invalid amount digit '+'")
+	})
+
+	t.Run("Marshal and Unmarshal big.Rat", func(t *testing.T) {
+		amount := NewAmount(spec)
+		require.NoError(t, amount.Marshal(big.NewRat(12345, 100)))
+
+		units, _ := amount.Value()
+		require.Equal(t, int64(12345), units)
+
+		got := new(big.Rat)
+		require.NoError(t, amount.Unmarshal(got))
+		require.Equal(t, big.NewRat(12345, 100), got)
+
+		err := amount.Marshal(big.NewRat(1, 1000))
+		require.EqualError(t, err, "amount 0.001 can't be represented with scale 2")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		amount := NewAmount(spec)
+		amount.SetValue(-12345)
+
+		b, err := json.Marshal(amount)
+		require.NoError(t, err)
+		require.Equal(t, "-123.45", string(b))
+
+		amount = NewAmount(spec)
+		require.NoError(t, json.Unmarshal([]byte("10.5"), amount))
+
+		units, _ := amount.Value()
+		require.Equal(t, int64(1050), units)
+	})
+}
+
+func TestAmountFieldSign(t *testing.T) {
+	tests := []struct {
+		name     string
+		sign     AmountSign
+		units    int64
+		expected string
+	}{
+		{"C/D positive", AmountSignCD, 1500, "C00001500"},
+		{"C/D negative", AmountSignCD, -1500, "D00001500"},
+		{"+/- positive", AmountSignPlusMinus, 1500, "+00001500"},
+		{"+/- negative", AmountSignPlusMinus, -1500, "-00001500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &Spec{
+				Length:      9,
+				Description: "Amount, Transaction Fee",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Amount:      &AmountOptions{Scale: 2, Sign: tt.sign},
+			}
+
+			amount := NewAmount(spec)
+			amount.SetValue(tt.units)
+
+			packed, err := amount.Pack()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, string(packed))
+
+			amount = NewAmount(spec)
+			_, err = amount.Unpack(packed)
+			require.NoError(t, err)
+
+			units, _ := amount.Value()
+			require.Equal(t, tt.units, units)
+		})
+	}
+
+	t.Run("Unpack returns error for invalid sign", func(t *testing.T) {
+		amount := NewAmount(&Spec{
+			Length: 9,
+			Enc:    encoding.ASCII,
+			Pref:   prefix.ASCII.Fixed,
+			Amount: &AmountOptions{Sign: AmountSignCD},
+		})
+
+		_, err := amount.Unpack([]byte("X00001500"))
+		require.EqualError(t, err, "failed to set bytes: invalid amount sign 'X'")
+	})
+}
@@ -13,6 +13,21 @@ var _ Field = (*Binary)(nil)
 var _ json.Marshaler = (*Binary)(nil)
 var _ json.Unmarshaler = (*Binary)(nil)
 
+// BinaryOptions defines options of the Binary field type.
+type BinaryOptions struct {
+	// ChunkSize defines the size of chunks the field value is split into
+	// when it's unmarshaled into *[][]byte (e.g. 8 for a list of
+	// concatenated keys). When marshaled from *[][]byte, chunks are
+	// concatenated.
+	ChunkSize int
+	// PrefixOffset defines the number of content bytes that precede the
+	// length prefix. The prefix then holds the length of the remaining
+	// content, e.g. with offset 1 the layout is one data byte, the length
+	// of the rest and the rest of the data. The Spec.Length covers the
+	// whole content.
+	PrefixOffset int
+}
+
 type Binary struct {
 	value []byte
 	spec  *Spec
@@ -39,6 +54,15 @@ func (f *Binary) SetSpec(spec *Spec) {
 	f.spec = spec
 }
 
+// options returns the binary options of the spec or zero options if they
+// are not defined.
+func (f *Binary) options() BinaryOptions {
+	if f.spec == nil || f.spec.Binary == nil {
+		return BinaryOptions{}
+	}
+	return *f.spec.Binary
+}
+
 func (f *Binary) SetBytes(b []byte) error {
 	f.value = b
 	if f.data != nil {
@@ -75,16 +99,32 @@ func (f *Binary) SetValue(v []byte) {
 func (f *Binary) Pack() ([]byte, error) {
 	data := f.value
 
+	if err := validate(f.spec, data); err != nil {
+		return nil, err
+	}
+
 	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
 		data = f.spec.Pad.Pad(data, f.spec.Length)
 	}
 
+	if f.options().PrefixOffset > 0 {
+		return f.packWithPrefixOffset(data)
+	}
+
 	packed, err := f.spec.Enc.Encode(data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode content: %w", err)
 	}
 
-	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	length := len(data)
+	if lc, ok := f.spec.Enc.(encoding.LengthCounter); ok {
+		length = lc.Length(data)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, length)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode length: %w", err)
 	}
@@ -93,6 +133,10 @@ func (f *Binary) Pack() ([]byte, error) {
 }
 
 func (f *Binary) Unpack(data []byte) (int, error) {
+	if f.options().PrefixOffset > 0 {
+		return f.unpackWithPrefixOffset(data)
+	}
+
 	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
 	if err != nil {
 		return 0, fmt.Errorf("failed to decode length: %w", err)
@@ -107,6 +151,10 @@ func (f *Binary) Unpack(data []byte) (int, error) {
 		raw = f.spec.Pad.Unpad(raw)
 	}
 
+	if err := validate(f.spec, raw); err != nil {
+		return 0, err
+	}
+
 	if err := f.SetBytes(raw); err != nil {
 		return 0, fmt.Errorf("failed to set bytes: %w", err)
 	}
@@ -114,38 +162,139 @@ func (f *Binary) Unpack(data []byte) (int, error) {
 	return read + prefBytes, nil
 }
 
+// packWithPrefixOffset packs the first BinaryOptions.PrefixOffset bytes of data,
+// then the length of the remaining data and the remaining data.
+func (f *Binary) packWithPrefixOffset(data []byte) ([]byte, error) {
+	offset := f.options().PrefixOffset
+	if len(data) < offset {
+		return nil, fmt.Errorf("data length %d is less than prefix offset %d", len(data), offset)
+	}
+
+	packedHead, err := f.spec.Enc.Encode(data[:offset])
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedRest, err := f.spec.Enc.Encode(data[offset:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length-offset, len(data)-offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	packed := append(packedHead, packedLength...)
+	return append(packed, packedRest...), nil
+}
+
+// unpackWithPrefixOffset unpacks the data packed by packWithPrefixOffset.
+func (f *Binary) unpackWithPrefixOffset(data []byte) (int, error) {
+	offset := f.options().PrefixOffset
+
+	head, headRead, err := f.spec.Enc.Decode(data, offset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length-offset, data[headRead:])
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	rest, restRead, err := f.spec.Enc.Decode(data[headRead+prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	raw := append(append([]byte{}, head...), rest...)
+
+	if f.spec.Pad != nil {
+		raw = f.spec.Pad.Unpad(raw)
+	}
+
+	if err := validate(f.spec, raw); err != nil {
+		return 0, err
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return headRead + prefBytes + restRead, nil
+}
+
+// Unmarshal sets field value into v which should be either *Binary or
+// *[][]byte. For *[][]byte the value is split into chunks of the
+// BinaryOptions.ChunkSize.
 func (f *Binary) Unmarshal(v interface{}) error {
 	if v == nil {
 		return nil
 	}
 
-	bin, ok := v.(*Binary)
-	if !ok {
+	switch val := v.(type) {
+	case *Binary:
+		val.value = f.value
+	case *[][]byte:
+		chunks, err := f.chunks()
+		if err != nil {
+			return err
+		}
+		*val = chunks
+	default:
 		return errors.New("data does not match required *Binary type")
 	}
 
-	bin.value = f.value
-
 	return nil
 }
 
+// SetData sets field value from data which should be either *Binary or
+// *[][]byte. For *[][]byte the chunks are concatenated.
 func (f *Binary) SetData(data interface{}) error {
 	if data == nil {
 		return nil
 	}
 
-	bin, ok := data.(*Binary)
-	if !ok {
+	switch val := data.(type) {
+	case *Binary:
+		f.data = val
+		if val.value != nil {
+			f.value = val.value
+		}
+	case *[][]byte:
+		var value []byte
+		for _, chunk := range *val {
+			value = append(value, chunk...)
+		}
+		f.value = value
+	default:
 		return errors.New("data does not match required *Binary type")
 	}
 
-	f.data = bin
-	if bin.value != nil {
-		f.value = bin.value
-	}
 	return nil
 }
 
+func (f *Binary) chunks() ([][]byte, error) {
+	size := f.options().ChunkSize
+	if size <= 0 {
+		return nil, errors.New("chunk size should be defined to unmarshal into *[][]byte")
+	}
+
+	if len(f.value)%size != 0 {
+		return nil, fmt.Errorf("data length %d is not a multiple of chunk size %d", len(f.value), size)
+	}
+
+	chunks := make([][]byte, 0, len(f.value)/size)
+	for i := 0; i < len(f.value); i += size {
+		chunk := make([]byte, size)
+		copy(chunk, f.value[i:i+size])
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks, nil
+}
+
 func (f *Binary) Marshal(data interface{}) error {
 	return f.SetData(data)
 }
@@ -1,10 +1,14 @@
 package field
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/padding"
 	"github.com/moov-io/iso8583/prefix"
+	"github.com/moov-io/iso8583/sort"
 	"github.com/stretchr/testify/require"
 )
 
@@ -140,3 +144,193 @@ func TestBinaryNil(t *testing.T) {
 	bs = str.Value()
 	require.Nil(t, bs)
 }
+
+func TestBinaryFieldWithBase64(t *testing.T) {
+	spec := &Spec{
+		Length:      999,
+		Description: "Certificate",
+		Enc:         encoding.Base64,
+		Pref:        prefix.ASCII.LLL,
+	}
+
+	bin := NewBinary(spec)
+	require.NoError(t, bin.SetBytes([]byte{0x30, 0x82, 0x01}))
+
+	packed, err := bin.Pack()
+	require.NoError(t, err)
+	// the prefix holds the number of base64 characters
+	require.Equal(t, "004MIIB", string(packed))
+
+	bin = NewBinary(spec)
+	read, err := bin.Unpack(append(packed, "Y2Vy"...))
+	require.NoError(t, err)
+	require.Equal(t, 7, read)
+	require.Equal(t, []byte{0x30, 0x82, 0x01}, bin.Value())
+}
+
+func TestBinaryChunks(t *testing.T) {
+	spec := &Spec{
+		Length:      24,
+		Description: "Keys",
+		Enc:         encoding.Binary,
+		Pref:        prefix.Binary.Fixed,
+		Binary:      &BinaryOptions{ChunkSize: 8},
+	}
+
+	keys := [][]byte{
+		{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
+		{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02},
+		{0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03},
+	}
+
+	t.Run("Unmarshal splits value into chunks", func(t *testing.T) {
+		bin := NewBinary(spec)
+		_, err := bin.Unpack(bytes.Join(keys, nil))
+		require.NoError(t, err)
+
+		var got [][]byte
+		require.NoError(t, bin.Unmarshal(&got))
+		require.Equal(t, keys, got)
+	})
+
+	t.Run("Marshal concatenates chunks", func(t *testing.T) {
+		bin := NewBinary(spec)
+		require.NoError(t, bin.Marshal(&keys))
+
+		packed, err := bin.Pack()
+		require.NoError(t, err)
+		require.Equal(t, bytes.Join(keys, nil), packed)
+	})
+
+	t.Run("Unmarshal returns error if length is not a multiple of chunk size", func(t *testing.T) {
+		bin := NewBinary(spec)
+		require.NoError(t, bin.SetBytes(make([]byte, 20)))
+
+		var got [][]byte
+		err := bin.Unmarshal(&got)
+		require.EqualError(t, err, "data length 20 is not a multiple of chunk size 8")
+	})
+
+	t.Run("Unmarshal returns error if chunk size is not defined", func(t *testing.T) {
+		bin := NewBinary(&Spec{})
+		require.NoError(t, bin.SetBytes(make([]byte, 16)))
+
+		var got [][]byte
+		err := bin.Unmarshal(&got)
+		require.EqualError(t, err, "chunk size should be defined to unmarshal into *[][]byte")
+	})
+}
+
+func TestBinaryTrimRightPadding(t *testing.T) {
+	spec := &Spec{
+		Length:      8,
+		Description: "Field",
+		Enc:         encoding.Binary,
+		Pref:        prefix.Binary.Fixed,
+		Pad:         padding.TrimRight(0xFF),
+	}
+
+	bin := NewBinary(spec)
+
+	read, err := bin.Unpack([]byte{'A', 'B', 'C', 0xFF, 0xFF, 0xFF, 0xFF, 0xFF})
+	require.NoError(t, err)
+	require.Equal(t, 8, read)
+	require.Equal(t, []byte("ABC"), bin.Value())
+
+	// value is packed as is
+	bin = NewBinary(spec)
+	require.NoError(t, bin.SetBytes([]byte("ABCDEFGH")))
+
+	packed, err := bin.Pack()
+	require.NoError(t, err)
+	require.Equal(t, []byte("ABCDEFGH"), packed)
+}
+
+func TestBinaryAsCompositeSubfield(t *testing.T) {
+	type cryptogramData struct {
+		F1 *String
+		F2 *Binary
+	}
+
+	spec := &Spec{
+		Length:      12,
+		Description: "Chip Data",
+		Pref:        prefix.Binary.Fixed,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"1": NewString(&Spec{
+				Length:      4,
+				Description: "Cryptogram Type",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"2": NewBinary(&Spec{
+				Length:      8,
+				Description: "Application Cryptogram",
+				Enc:         encoding.Binary,
+				Pref:        prefix.Binary.Fixed,
+			}),
+		},
+	}
+
+	cryptogram := []byte{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88}
+
+	composite := NewComposite(spec)
+	require.NoError(t, composite.Marshal(&cryptogramData{
+		F1: NewStringValue("ARQC"),
+		F2: NewBinaryValue(cryptogram),
+	}))
+
+	packed, err := composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, append([]byte("ARQC"), cryptogram...), packed)
+
+	composite = NewComposite(spec)
+	_, err = composite.Unpack(packed)
+	require.NoError(t, err)
+
+	data := &cryptogramData{}
+	require.NoError(t, composite.Unmarshal(data))
+	require.Equal(t, cryptogram, data.F2.Value())
+
+	b, err := json.Marshal(composite)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"1":"ARQC","2":"1122334455667788"}`, string(b))
+}
+
+func TestBinaryPrefixOffset(t *testing.T) {
+	spec := &Spec{
+		Length:      10,
+		Description: "Interleaved Data",
+		Enc:         encoding.Binary,
+		Pref:        prefix.Binary.L,
+		Binary:      &BinaryOptions{PrefixOffset: 1},
+	}
+
+	// one header byte, then the length of the rest, then the rest
+	packed := []byte{0x7F, 0x03, 0x01, 0x02, 0x03}
+
+	field := NewBinaryValue([]byte{0x7F, 0x01, 0x02, 0x03})
+	field.SetSpec(spec)
+
+	got, err := field.Pack()
+	require.NoError(t, err)
+	require.Equal(t, packed, got)
+
+	field = NewBinary(spec)
+	read, err := field.Unpack(append(packed, 0xFF))
+	require.NoError(t, err)
+	require.Equal(t, len(packed), read)
+	require.Equal(t, []byte{0x7F, 0x01, 0x02, 0x03}, field.Value())
+
+	field = NewBinaryValue([]byte{})
+	field.SetSpec(spec)
+
+	_, err = field.Pack()
+	require.EqualError(t, err, "data length 0 is less than prefix offset 1")
+
+	_, err = NewBinary(spec).Unpack([]byte{0x7F, 0x0A, 0x01})
+	require.EqualError(t, err, "failed to decode length: data length: 10 is larger than maximum 9")
+}
@@ -164,6 +164,20 @@ func (f *Bitmap) Set(n int) {
 		return
 	}
 
+	f.Expand(n)
+
+	if n > len(f.data)*8 {
+		return
+	}
+
+	// set bit
+	f.data[(n-1)/8] |= 1 << (uint(7-(n-1)) % 8)
+}
+
+// Expand adds bitmaps (setting the bits that show the presence of the next
+// bitmap) until the bitmap covers n fields. It does nothing if the bitmap
+// already covers n fields or if DisableAutoExpand is set.
+func (f *Bitmap) Expand(n int) {
 	// do we have to expand bitmap?
 	if n > len(f.data)*8 {
 		if f.spec.DisableAutoExpand {
@@ -190,9 +204,6 @@ func (f *Bitmap) Set(n int) {
 			f.data = append(f.data, newBitmap...)
 		}
 	}
-
-	// set bit
-	f.data[(n-1)/8] |= 1 << (uint(7-(n-1)) % 8)
 }
 
 func (f *Bitmap) IsSet(n int) bool {
@@ -0,0 +1,235 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/iso8583/utils"
+)
+
+var _ Field = (*CodeField)(nil)
+var _ json.Marshaler = (*CodeField)(nil)
+var _ json.Unmarshaler = (*CodeField)(nil)
+
+// CodeOptions defines options of the CodeField field type.
+type CodeOptions struct {
+	// AllowedValues defines the vocabulary of the field (e.g. the response
+	// codes "00", "05"). Any other value is rejected on Unpack, Marshal and
+	// Pack.
+	AllowedValues []string
+}
+
+// CodeField is a string field (e.g. a response code) that only permits
+// values from the CodeOptions.AllowedValues vocabulary. Values are checked on
+// Unpack, Marshal and Pack.
+type CodeField struct {
+	value string
+	spec  *Spec
+	data  *CodeField
+}
+
+// NewCodeField returns a code field permitting only the allowed values. The
+// values are set as CodeOptions.AllowedValues of a copy of the spec, so the
+// spec passed in is not modified.
+func NewCodeField(spec *Spec, allowed []string) *CodeField {
+	codeSpec := *spec
+	codeSpec.Code = &CodeOptions{
+		AllowedValues: append([]string(nil), allowed...),
+	}
+
+	return &CodeField{
+		spec: &codeSpec,
+	}
+}
+
+func NewCodeFieldValue(val string) *CodeField {
+	return &CodeField{
+		value: val,
+	}
+}
+
+func (f *CodeField) Spec() *Spec {
+	return f.spec
+}
+
+func (f *CodeField) SetSpec(spec *Spec) {
+	f.spec = spec
+}
+
+func (f *CodeField) SetBytes(b []byte) error {
+	f.value = string(b)
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
+}
+
+func (f *CodeField) Bytes() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+	return []byte(f.value), nil
+}
+
+func (f *CodeField) String() (string, error) {
+	if f == nil {
+		return "", nil
+	}
+	return f.value, nil
+}
+
+func (f *CodeField) Value() string {
+	if f == nil {
+		return ""
+	}
+	return f.value
+}
+
+func (f *CodeField) SetValue(v string) {
+	f.value = v
+}
+
+func (f *CodeField) Pack() ([]byte, error) {
+	if err := f.checkAllowed(f.value); err != nil {
+		return nil, err
+	}
+
+	data := []byte(f.value)
+
+	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
+		data = f.spec.Pad.Pad(data, f.spec.Length)
+	}
+
+	packed, err := f.spec.Enc.Encode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	return append(packedLength, packed...), nil
+}
+
+// returns number of bytes was read
+func (f *CodeField) Unpack(data []byte) (int, error) {
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	raw, read, err := f.spec.Enc.Decode(data[prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	if f.spec.Pad != nil {
+		raw = f.spec.Pad.Unpad(raw)
+	}
+
+	if err := f.checkAllowed(string(raw)); err != nil {
+		return 0, err
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return read + prefBytes, nil
+}
+
+// Unmarshal sets field value into v which should be either *CodeField or
+// *string.
+func (f *CodeField) Unmarshal(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case *CodeField:
+		val.value = f.value
+	case *string:
+		*val = f.value
+	default:
+		return errors.New("data does not match required *CodeField or *string type")
+	}
+
+	return nil
+}
+
+// SetData sets field value from data which should be either *CodeField or
+// *string.
+func (f *CodeField) SetData(data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	switch val := data.(type) {
+	case *CodeField:
+		f.data = val
+		if val.value != "" {
+			f.value = val.value
+		}
+	case *string:
+		f.value = *val
+	default:
+		return errors.New("data does not match required *CodeField or *string type")
+	}
+
+	return nil
+}
+
+// Marshal sets field value from data and checks that it's permitted.
+func (f *CodeField) Marshal(data interface{}) error {
+	if err := f.SetData(data); err != nil {
+		return err
+	}
+
+	return f.checkAllowed(f.value)
+}
+
+func (f *CodeField) MarshalJSON() ([]byte, error) {
+	bytes, err := json.Marshal(f.value)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to JSON marshal string to bytes")
+	}
+	return bytes, nil
+}
+
+func (f *CodeField) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to string")
+	}
+	return f.SetBytes([]byte(v))
+}
+
+func (f *CodeField) checkAllowed(value string) error {
+	if f.spec == nil {
+		return nil
+	}
+
+	var allowedValues []string
+	if f.spec.Code != nil {
+		allowedValues = f.spec.Code.AllowedValues
+	}
+	if len(allowedValues) == 0 {
+		return errors.New("allowed values should be defined for code field")
+	}
+
+	for _, allowed := range allowedValues {
+		if value == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("value %q is not permitted, allowed values: %s", value, strings.Join(allowedValues, ", "))
+}
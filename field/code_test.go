@@ -0,0 +1,92 @@
+package field
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/moov-io/iso8583/sort"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeField(t *testing.T) {
+	spec := &Spec{
+		Length:      2,
+		Description: "Response Code",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+	}
+	allowed := []string{"00", "05", "51"}
+
+	t.Run("Unpack accepts allowed value", func(t *testing.T) {
+		code := NewCodeField(spec, allowed)
+
+		read, err := code.Unpack([]byte("05"))
+		require.NoError(t, err)
+		require.Equal(t, 2, read)
+		require.Equal(t, "05", code.Value())
+
+		var value string
+		require.NoError(t, code.Unmarshal(&value))
+		require.Equal(t, "05", value)
+	})
+
+	t.Run("Unpack returns error for value not permitted", func(t *testing.T) {
+		_, err := NewCodeField(spec, allowed).Unpack([]byte("99"))
+		require.EqualError(t, err, `value "99" is not permitted, allowed values: 00, 05, 51`)
+	})
+
+	t.Run("Marshal returns error for value not permitted", func(t *testing.T) {
+		code := NewCodeField(spec, allowed)
+		require.NoError(t, code.Marshal(NewCodeFieldValue("51")))
+
+		err := code.Marshal(NewCodeFieldValue("99"))
+		require.EqualError(t, err, `value "99" is not permitted, allowed values: 00, 05, 51`)
+
+		_, err = code.Pack()
+		require.EqualError(t, err, `value "99" is not permitted, allowed values: 00, 05, 51`)
+	})
+
+	t.Run("subfield created from spec keeps allowed values", func(t *testing.T) {
+		composite := NewComposite(&Spec{
+			Length: 2,
+			Pref:   prefix.ASCII.Fixed,
+			Tag: &TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"1": NewCodeField(spec, allowed),
+			},
+		})
+
+		_, err := composite.Unpack([]byte("00"))
+		require.NoError(t, err)
+
+		_, err = composite.Unpack([]byte("99"))
+		require.EqualError(t, err, `failed to unpack subfield 1: value "99" is not permitted, allowed values: 00, 05, 51`)
+	})
+
+	t.Run("spec passed to NewCodeField is not modified", func(t *testing.T) {
+		NewCodeField(spec, allowed)
+		require.Nil(t, spec.Code)
+	})
+
+	t.Run("Unpack returns error if allowed values are not defined", func(t *testing.T) {
+		code := &CodeField{}
+		code.SetSpec(spec)
+
+		_, err := code.Unpack([]byte("00"))
+		require.EqualError(t, err, "allowed values should be defined for code field")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		b, err := json.Marshal(NewCodeFieldValue("00"))
+		require.NoError(t, err)
+		require.Equal(t, `"00"`, string(b))
+
+		code := NewCodeField(spec, allowed)
+		require.NoError(t, json.Unmarshal(b, code))
+		require.Equal(t, "00", code.Value())
+	})
+}
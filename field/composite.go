@@ -1,6 +1,7 @@
 package field
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,6 +22,61 @@ var _ Field = (*Composite)(nil)
 var _ json.Marshaler = (*Composite)(nil)
 var _ json.Unmarshaler = (*Composite)(nil)
 
+// DefaultMaxCompositeDepth is the maximum nesting level of composite fields
+// used when CompositeOptions.MaxDepth is not set.
+const DefaultMaxCompositeDepth = 32
+
+// CompositeAlign defines how the packed subfields of a fixed length
+// composite are aligned within the spec length.
+type CompositeAlign int
+
+const (
+	// CompositeAlignNone doesn't fill the packed subfields.
+	CompositeAlignNone CompositeAlign = iota
+	// CompositeAlignLeft packs the subfields first and fills the rest of
+	// the spec length after them.
+	CompositeAlignLeft
+	// CompositeAlignRight fills the beginning of the spec length and packs
+	// the subfields after the fill.
+	CompositeAlignRight
+)
+
+// CompositeOptions defines options of the Composite field type.
+type CompositeOptions struct {
+	// BlockPad pads the packed subfields to a multiple of the block size
+	// (e.g. for encryption) and strips the padding on unpack. Unlike
+	// Spec.Pad, it's applied to the composite data as a whole and before
+	// the length prefix is encoded.
+	BlockPad padding.BlockPadder
+	// DisallowUnknownSubfields makes the field return an error when the
+	// struct passed to Marshal or Unmarshal has a field with an index tag
+	// that doesn't match any subfield in the spec. By default, such struct
+	// fields are ignored.
+	DisallowUnknownSubfields bool
+	// JSONAliases maps subfield tags to the keys (e.g. "pan") used for them
+	// in JSON produced by MarshalJSON and accepted by UnmarshalJSON.
+	// Subfields without an alias use their tag. The packed data is not
+	// affected.
+	JSONAliases map[string]string
+	// Align aligns the packed subfields within the spec length and fills
+	// the rest of it with the Fill byte. Unlike Spec.Pad of the subfields,
+	// it's applied to the packed subfields as a whole. On unpack of a
+	// left-aligned composite, the subfields are unpacked from the data as
+	// is and the rest of the data should hold only the fill. For right
+	// alignment, all leading Fill bytes are taken as the fill, so the
+	// packed subfields should not start with the Fill byte. Only fixed
+	// length composites can be aligned.
+	Align CompositeAlign
+	// Fill defines the byte (e.g. ' ') used to fill an aligned composite.
+	Fill byte
+	// MaxDepth defines the maximum nesting level of composite subfields (a
+	// composite without composite subfields has level 1). Unpack and
+	// Marshal of a composite with deeper nesting (e.g. from a malformed or
+	// recursive spec) return an error. When not set,
+	// DefaultMaxCompositeDepth is used.
+	MaxDepth int
+}
+
 // Composite is a wrapper object designed to hold ISO8583 TLVs, subfields and
 // subelements. Because Composite handles both of these usecases generically,
 // we refer to them collectively as 'subfields' throughout the receiver's
@@ -67,6 +123,9 @@ type Composite struct {
 	spec   *Spec
 	bitmap *Bitmap
 
+	// nesting level of the spec computed when the spec is set
+	depth int
+
 	orderedSpecFieldTags []string
 
 	// stores all fields according to the spec
@@ -74,6 +133,9 @@ type Composite struct {
 
 	// tracks which subfields were set
 	setSubfields map[string]struct{}
+
+	// tracks which lenient subfields were skipped during unpacking
+	skippedSubfields map[string]error
 }
 
 // NewComposite creates a new instance of the *Composite struct,
@@ -98,6 +160,7 @@ func (f *Composite) ConstructSubfields() {
 		f.subfields = CreateSubfields(f.spec)
 	}
 	f.setSubfields = make(map[string]struct{})
+	f.skippedSubfields = make(map[string]error)
 }
 
 // Spec returns the receiver's spec.
@@ -105,6 +168,15 @@ func (f *Composite) Spec() *Spec {
 	return f.spec
 }
 
+// options returns the composite options of the spec or zero options if they
+// are not defined.
+func (f *Composite) options() CompositeOptions {
+	if f.spec == nil || f.spec.Composite == nil {
+		return CompositeOptions{}
+	}
+	return *f.spec.Composite
+}
+
 // GetSubfields returns the map of set sub fields
 func (f *Composite) GetSubfields() map[string]Field {
 	fields := map[string]Field{}
@@ -114,11 +186,23 @@ func (f *Composite) GetSubfields() map[string]Field {
 	return fields
 }
 
+// SkippedSubfields returns the decode errors of the subfields that were
+// skipped during the last unpacking because their spec has LenientDecode set.
+// The map is keyed by subfield tag.
+func (f *Composite) SkippedSubfields() map[string]error {
+	skipped := map[string]error{}
+	for tag, err := range f.skippedSubfields {
+		skipped[tag] = err
+	}
+	return skipped
+}
+
 // SetSpec validates the spec and creates new instances of Subfields defined
 // in the specification.
 // NOTE: Composite does not support padding on the base spec. Therefore, users
 // should only pass None or nil values for ths type. Passing any other value
-// will result in a panic.
+// will result in a panic. CompositeOptions.Align can be used to fill a fixed
+// length composite instead.
 func (f *Composite) SetSpec(spec *Spec) {
 	if err := validateCompositeSpec(spec); err != nil {
 		panic(err)
@@ -135,6 +219,7 @@ func (f *Composite) SetSpec(spec *Spec) {
 	}
 
 	f.orderedSpecFieldTags = orderedKeys(spec.Subfields, sortFn)
+	f.depth = compositeDepth(spec, f.maxDepth())
 }
 
 func (f *Composite) Unmarshal(v interface{}) error {
@@ -164,6 +249,9 @@ func (f *Composite) Unmarshal(v interface{}) error {
 
 		messageField, ok := f.subfields[indexOrTag]
 		if !ok {
+			if f.options().DisallowUnknownSubfields {
+				return fmt.Errorf("failed to get data from field %s: no specification found", indexOrTag)
+			}
 			continue
 		}
 
@@ -204,6 +292,10 @@ func (f *Composite) SetData(v interface{}) error {
 //	    F4 *SubfieldCompositeData
 //	}
 func (f *Composite) Marshal(v interface{}) error {
+	if err := f.checkDepth(); err != nil {
+		return err
+	}
+
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
 		return errors.New("data is not a pointer or nil")
@@ -230,6 +322,9 @@ func (f *Composite) Marshal(v interface{}) error {
 
 		messageField, ok := f.subfields[indexOrTag]
 		if !ok {
+			if f.options().DisallowUnknownSubfields {
+				return fmt.Errorf("failed to set data from field %s: no specification found", indexOrTag)
+			}
 			continue
 		}
 
@@ -269,6 +364,10 @@ func (f *Composite) Pack() ([]byte, error) {
 // subfields. An offset (unit depends on encoding and prefix values) is
 // returned on success. A non-nil error is returned on failure.
 func (f *Composite) Unpack(data []byte) (int, error) {
+	if err := f.checkDepth(); err != nil {
+		return 0, err
+	}
+
 	dataLen, offset, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
 	if err != nil {
 		return 0, fmt.Errorf("failed to decode length: %w", err)
@@ -346,7 +445,16 @@ func (f *Composite) String() (string, error) {
 
 // MarshalJSON implements the encoding/json.Marshaler interface.
 func (f *Composite) MarshalJSON() ([]byte, error) {
-	jsonData := OrderedMap(f.GetSubfields())
+	jsonData := OrderedMap{}
+	for tag, subfield := range f.GetSubfields() {
+		// skip subfields that are not packed, so JSON matches the packed
+		// composite
+		if isAbsent(subfield) {
+			continue
+		}
+		jsonData[f.jsonKey(tag)] = subfield
+	}
+
 	bytes, err := json.Marshal(jsonData)
 	if err != nil {
 		return nil, utils.NewSafeError(err, "failed to JSON marshal map to bytes")
@@ -354,9 +462,19 @@ func (f *Composite) MarshalJSON() ([]byte, error) {
 	return bytes, nil
 }
 
+// jsonKey returns the key of the subfield in JSON: its alias from
+// CompositeOptions.JSONAliases or the tag itself.
+func (f *Composite) jsonKey(tag string) string {
+	if alias, ok := f.options().JSONAliases[tag]; ok {
+		return alias
+	}
+	return tag
+}
+
 // UnmarshalJSON implements the encoding/json.Unmarshaler interface.
 // An error is thrown if the JSON consists of a subfield that has not
-// been defined in the spec.
+// been defined in the spec. Keys are matched against
+// CompositeOptions.JSONAliases first and then against the subfield tags.
 func (f *Composite) UnmarshalJSON(b []byte) error {
 	var data map[string]json.RawMessage
 	err := json.Unmarshal(b, &data)
@@ -364,7 +482,17 @@ func (f *Composite) UnmarshalJSON(b []byte) error {
 		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to map")
 	}
 
-	for tag, rawMsg := range data {
+	tags := map[string]string{}
+	for tag, alias := range f.options().JSONAliases {
+		tags[alias] = tag
+	}
+
+	for key, rawMsg := range data {
+		tag := key
+		if aliasedTag, ok := tags[key]; ok {
+			tag = aliasedTag
+		}
+
 		if _, ok := f.spec.Subfields[tag]; !ok && !f.skipUnknownTLVTags() {
 			return fmt.Errorf("failed to unmarshal subfield %v: received subfield not defined in spec", tag)
 		}
@@ -385,11 +513,32 @@ func (f *Composite) UnmarshalJSON(b []byte) error {
 }
 
 func (f *Composite) pack() ([]byte, error) {
+	var packed []byte
+	var err error
+
 	if f.Bitmap() != nil {
-		return f.packByBitmap()
+		packed, err = f.packByBitmap()
+	} else {
+		packed, err = f.packByTag()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if align := f.options().Align; align != CompositeAlignNone && len(packed) < f.spec.Length {
+		fill := bytes.Repeat([]byte{f.options().Fill}, f.spec.Length-len(packed))
+		if align == CompositeAlignLeft {
+			packed = append(packed, fill...)
+		} else {
+			packed = append(fill, packed...)
+		}
+	}
+
+	if blockPad := f.options().BlockPad; blockPad != nil {
+		packed = blockPad.Pad(packed)
 	}
 
-	return f.packByTag()
+	return packed, nil
 }
 
 func (f *Composite) packByBitmap() ([]byte, error) {
@@ -397,6 +546,10 @@ func (f *Composite) packByBitmap() ([]byte, error) {
 
 	// Set bitmap bits for all fields that are present.
 	for id := range f.setSubfields {
+		if isAbsent(f.subfields[id]) {
+			continue
+		}
+
 		idInt, err := strconv.Atoi(id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to pack composite: %w", err)
@@ -422,6 +575,9 @@ func (f *Composite) packByBitmap() ([]byte, error) {
 		if !ok {
 			return nil, fmt.Errorf("failed to pack subfield %s: no specification found", i)
 		}
+		if isAbsent(field) {
+			continue
+		}
 		packedField, err := field.Pack()
 		if err != nil {
 			return nil, fmt.Errorf("failed to pack subfield %s (%s): %w", i, field.Spec().Description, err)
@@ -440,7 +596,7 @@ func (f *Composite) packByTag() ([]byte, error) {
 			return nil, fmt.Errorf("no subfield for tag %s", tag)
 		}
 
-		if _, set := f.setSubfields[tag]; !set {
+		if _, set := f.setSubfields[tag]; !set || isAbsent(field) {
 			continue
 		}
 
@@ -468,11 +624,144 @@ func (f *Composite) packByTag() ([]byte, error) {
 }
 
 func (f *Composite) unpack(data []byte, isVariableLength bool) (int, error) {
+	f.skippedSubfields = make(map[string]error)
+
+	blockPad := f.options().BlockPad
+	aligned := f.options().Align != CompositeAlignNone
+	if blockPad == nil && !aligned {
+		return f.unpackData(data, isVariableLength)
+	}
+
+	unpadded := data
+	if blockPad != nil {
+		var err error
+		unpadded, err = blockPad.Unpad(unpadded)
+		if err != nil {
+			return 0, fmt.Errorf("failed to unpad composite: %w", err)
+		}
+	}
+
+	if aligned {
+		if err := f.unpackAligned(unpadded); err != nil {
+			return 0, err
+		}
+
+		// fill is a part of the composite data
+		return len(data), nil
+	}
+
+	read, err := f.unpackData(unpadded, isVariableLength)
+	if err != nil {
+		return 0, err
+	}
+	if read != len(unpadded) {
+		return 0, fmt.Errorf("data length: %v does not match aggregate data read from decoded subfields: %v", len(unpadded), read)
+	}
+
+	// padding is a part of the composite data
+	return len(data), nil
+}
+
+// maxDepth returns the maximum nesting level of the composite.
+func (f *Composite) maxDepth() int {
+	if maxDepth := f.options().MaxDepth; maxDepth > 0 {
+		return maxDepth
+	}
+	return DefaultMaxCompositeDepth
+}
+
+// checkDepth returns an error if the composite subfields are nested deeper
+// than the maximum nesting level.
+func (f *Composite) checkDepth() error {
+	if maxDepth := f.maxDepth(); f.depth > maxDepth {
+		return fmt.Errorf("composite nesting exceeds max depth %d", maxDepth)
+	}
+	return nil
+}
+
+// compositeDepth returns the nesting level of the composite spec. The level
+// of composite subfields created with the spec set is reused, so each spec
+// is walked once. Specs of other subfields are walked only until maxDepth
+// is exceeded, so it stops on recursive specs too.
+func compositeDepth(spec *Spec, maxDepth int) int {
+	depth := 1
+	for _, subfield := range spec.Subfields {
+		subdepth := 0
+		if composite, ok := subfield.(*Composite); ok && composite.depth > 0 {
+			subdepth = composite.depth
+		} else if subspec := subfield.Spec(); subspec != nil && len(subspec.Subfields) > 0 {
+			if maxDepth <= 1 {
+				return maxDepth + 1
+			}
+			subdepth = compositeDepth(subspec, maxDepth-1)
+		}
+
+		if subdepth+1 > depth {
+			depth = subdepth + 1
+		}
+		if depth > maxDepth {
+			return depth
+		}
+	}
+
+	return depth
+}
+
+// unpackAligned unpacks the subfields of an aligned composite from data
+// holding both the packed subfields and the fill. For left alignment, the
+// subfields are unpacked from data as is, so their trailing bytes equal to
+// the fill byte are kept, and the rest of data should hold only the fill.
+// For right alignment, the leading fill bytes are skipped and the subfields
+// are unpacked from the rest of data.
+func (f *Composite) unpackAligned(data []byte) error {
+	opts := f.options()
+
+	if opts.Align == CompositeAlignRight {
+		start := 0
+		for start < len(data) && data[start] == opts.Fill {
+			start++
+		}
+
+		read, err := f.unpackData(data[start:], false)
+		if err != nil {
+			return err
+		}
+		if read != len(data)-start {
+			return fmt.Errorf("data length: %v does not match aggregate data read from decoded subfields: %v", len(data)-start, read)
+		}
+
+		return nil
+	}
+
+	end := len(data)
+	for end > 0 && data[end-1] == opts.Fill {
+		end--
+	}
+
+	var read int
+	var err error
+	if f.Bitmap() == nil && f.spec.Tag.Enc != nil {
+		// tagged subfields are unpacked until the fill
+		read, err = f.unpackSubfieldsByTag(data, end)
+	} else {
+		read, err = f.unpackData(data, false)
+	}
+	if err != nil {
+		return err
+	}
+	if read < end {
+		return fmt.Errorf("data length: %v does not match aggregate data read from decoded subfields: %v", end, read)
+	}
+
+	return nil
+}
+
+func (f *Composite) unpackData(data []byte, isVariableLength bool) (int, error) {
 	if f.Bitmap() != nil {
 		return f.unpackSubfieldsByBitmap(data)
 	}
 	if f.spec.Tag.Enc != nil {
-		return f.unpackSubfieldsByTag(data)
+		return f.unpackSubfieldsByTag(data, len(data))
 	}
 	return f.unpackSubfields(data, isVariableLength)
 }
@@ -485,12 +774,14 @@ func (f *Composite) unpackSubfields(data []byte, isVariableLength bool) (int, er
 			continue
 		}
 
-		read, err := field.Unpack(data[offset:])
+		read, set, err := f.unpackSubfield(tag, field, data[offset:])
 		if err != nil {
 			return 0, fmt.Errorf("failed to unpack subfield %v: %w", tag, err)
 		}
 
-		f.setSubfields[tag] = struct{}{}
+		if set {
+			f.setSubfields[tag] = struct{}{}
+		}
 
 		offset += read
 
@@ -526,14 +817,16 @@ func (f *Composite) unpackSubfieldsByBitmap(data []byte) (int, error) {
 				return 0, fmt.Errorf("failed to unpack subfield %s: no specification found", iStr)
 			}
 
-			read, err = fl.Unpack(data[off:])
+			subfieldRead, set, err := f.unpackSubfield(iStr, fl, data[off:])
 			if err != nil {
 				return 0, fmt.Errorf("failed to unpack subfield %s (%s): %w", iStr, fl.Spec().Description, err)
 			}
 
-			f.setSubfields[iStr] = struct{}{}
+			if set {
+				f.setSubfields[iStr] = struct{}{}
+			}
 
-			off += read
+			off += subfieldRead
 		}
 	}
 
@@ -548,9 +841,11 @@ const (
 	maxLenOfUnknownTag = math.MaxInt
 )
 
-func (f *Composite) unpackSubfieldsByTag(data []byte) (int, error) {
+// unpackSubfieldsByTag unpacks tagged subfields from data until the end
+// offset is reached. Subfields may read data beyond the end offset.
+func (f *Composite) unpackSubfieldsByTag(data []byte, end int) (int, error) {
 	offset := 0
-	for offset < len(data) {
+	for offset < end {
 		tagBytes, read, err := f.spec.Tag.Enc.Decode(data[offset:], f.spec.Tag.Length)
 		if err != nil {
 			return 0, fmt.Errorf("failed to unpack subfield Tag: %w", err)
@@ -591,18 +886,77 @@ func (f *Composite) unpackSubfieldsByTag(data []byte) (int, error) {
 			continue
 		}
 
-		read, err = field.Unpack(data[offset:])
+		read, set, err := f.unpackSubfield(tag, field, data[offset:])
 		if err != nil {
 			return 0, fmt.Errorf("failed to unpack subfield %v: %w", tag, err)
 		}
 
-		f.setSubfields[tag] = struct{}{}
+		if set {
+			f.setSubfields[tag] = struct{}{}
+		}
 
 		offset += read
 	}
 	return offset, nil
 }
 
+// unpackSubfield unpacks a single subfield and reports whether it was set.
+// If the subfield fails to decode and its spec has LenientDecode enabled, the
+// error is recorded and the number of bytes occupied by the subfield is
+// returned so the caller can carry on with the next subfield.
+func (f *Composite) unpackSubfield(tag string, field Field, data []byte) (int, bool, error) {
+	read, err := field.Unpack(data)
+	if err == nil {
+		return read, true, nil
+	}
+
+	if !field.Spec().LenientDecode {
+		return 0, false, err
+	}
+
+	skip, skipErr := skipSubfield(field.Spec(), data)
+	if skipErr != nil {
+		return 0, false, err
+	}
+
+	f.skippedSubfields[tag] = err
+
+	return skip, false, nil
+}
+
+// skipSubfield returns the number of bytes the subfield occupies in data
+// without setting its value. The width of the content is computed from the
+// length prefix, so content the encoder can't decode is skipped too. Only
+// the content of encoders measuring the length in units of varying width
+// (e.g. runes) is decoded to find its width.
+func skipSubfield(spec *Spec, data []byte) (int, error) {
+	if spec.Enc == nil {
+		return 0, errors.New("subfield has no encoder")
+	}
+
+	dataLen, prefBytes, err := spec.Pref.DecodeLength(spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	width := dataLen
+	switch enc := spec.Enc.(type) {
+	case encoding.WidthCounter:
+		width = enc.Width(dataLen)
+	case encoding.LengthCounter:
+		_, width, err = spec.Enc.Decode(data[prefBytes:], dataLen)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode content: %w", err)
+		}
+	}
+
+	if prefBytes+width > len(data) {
+		return 0, fmt.Errorf("not enough data to skip, expected: %d, got: %d", prefBytes+width, len(data))
+	}
+
+	return prefBytes + width, nil
+}
+
 func (f *Composite) skipUnknownTLVTags() bool {
 	return f.spec.Tag != nil && f.spec.Tag.SkipUnknownTLVTags && (f.spec.Tag.Enc == encoding.BerTLVTag || f.spec.Tag.PrefUnknownTLV != nil)
 }
@@ -614,6 +968,11 @@ func validateCompositeSpec(spec *Spec) error {
 	if spec.Pad != nil && spec.Pad != padding.None {
 		return fmt.Errorf("Composite spec only supports nil or None spec padding values")
 	}
+	if spec.Composite != nil && spec.Composite.Align != CompositeAlignNone {
+		if _, ok := spec.Pref.(prefix.FixedLengthPrefixer); !ok {
+			return fmt.Errorf("Composite spec only supports alignment with a fixed length prefixer")
+		}
+	}
 	if (spec.Bitmap == nil && spec.Tag == nil) || (spec.Bitmap != nil && spec.Tag != nil) {
 		return fmt.Errorf("Composite spec only supports a definition of Bitmap or Tag, can't stand both or neither")
 	}
@@ -1061,7 +1061,7 @@ func TestCompositePackingWithTags(t *testing.T) {
 		read, err := composite.Unpack([]byte("180102AB0202CD03AB12"))
 		require.Equal(t, 0, read)
 		require.Error(t, err)
-		require.EqualError(t, err, "failed to unpack subfield 3: failed to decode length: strconv.Atoi: parsing \"AB\": invalid syntax")
+		require.EqualError(t, err, "failed to unpack subfield 3: failed to decode length: invalid length: \"AB\"")
 	})
 
 	t.Run("Unpack returns an error on data having subfield ID not in spec", func(t *testing.T) {
@@ -1334,7 +1334,7 @@ func TestCompositePackingWithBitmap(t *testing.T) {
 		read, err := composite.Unpack([]byte("30E020000000000000AB02AB060102YZ"))
 		require.Equal(t, 0, read)
 		require.Error(t, err)
-		require.EqualError(t, err, "failed to unpack subfield 1 (String Field): failed to decode length: strconv.Atoi: parsing \"AB\": invalid syntax")
+		require.EqualError(t, err, "failed to unpack subfield 1 (String Field): failed to decode length: invalid length: \"AB\"")
 	})
 
 	t.Run("Unpack returns an error on data having subfield ID not in spec with default bitmap", func(t *testing.T) {
@@ -1413,7 +1413,7 @@ func TestCompositePackingWithBitmap(t *testing.T) {
 		read, err := composite.Unpack([]byte("20E02000AB02CD060102YZ"))
 		require.Equal(t, 0, read)
 		require.Error(t, err)
-		require.EqualError(t, err, "failed to unpack subfield 1 (String Field): failed to decode length: strconv.Atoi: parsing \"AB\": invalid syntax")
+		require.EqualError(t, err, "failed to unpack subfield 1 (String Field): failed to decode length: invalid length: \"AB\"")
 	})
 
 	t.Run("Unpack returns an error on data having subfield ID not in spec with sized bitmap on 3 bytes", func(t *testing.T) {
@@ -1482,6 +1482,546 @@ func TestCompositePackingWithBitmap(t *testing.T) {
 	})
 }
 
+func TestCompositeLenientDecode(t *testing.T) {
+	spec := &Spec{
+		Length:      6,
+		Description: "Test Spec",
+		Pref:        prefix.ASCII.Fixed,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"1": NewString(&Spec{
+				Length:      2,
+				Description: "String Field",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"2": NewNumeric(&Spec{
+				Length:        2,
+				Description:   "Optional Numeric Field",
+				Enc:           encoding.ASCII,
+				Pref:          prefix.ASCII.Fixed,
+				LenientDecode: true,
+			}),
+			"3": NewNumeric(&Spec{
+				Length:      2,
+				Description: "Numeric Field",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		},
+	}
+
+	t.Run("Unpack skips malformed lenient subfield", func(t *testing.T) {
+		composite := NewComposite(spec)
+
+		read, err := composite.Unpack([]byte("ABXY12"))
+		require.NoError(t, err)
+		require.Equal(t, 6, read)
+
+		data := &CompositeTestData{}
+		require.NoError(t, composite.Unmarshal(data))
+
+		require.Equal(t, "AB", data.F1.Value())
+		require.Nil(t, data.F2)
+		require.Equal(t, 12, data.F3.Value())
+
+		skipped := composite.SkippedSubfields()
+		require.Len(t, skipped, 1)
+		require.EqualError(t, skipped["2"], "failed to set bytes: failed to convert into number")
+	})
+
+	t.Run("Unpack skips lenient subfield the encoder fails to decode", func(t *testing.T) {
+		composite := NewComposite(spec)
+
+		read, err := composite.Unpack([]byte("AB\xff\xff12"))
+		require.NoError(t, err)
+		require.Equal(t, 6, read)
+
+		data := &CompositeTestData{}
+		require.NoError(t, composite.Unmarshal(data))
+
+		require.Equal(t, "AB", data.F1.Value())
+		require.Nil(t, data.F2)
+		require.Equal(t, 12, data.F3.Value())
+
+		skipped := composite.SkippedSubfields()
+		require.Len(t, skipped, 1)
+		require.ErrorContains(t, skipped["2"], "failed to decode content")
+	})
+
+	t.Run("Unpack skips lenient subfield using encoder width", func(t *testing.T) {
+		composite := NewComposite(&Spec{
+			Length:      6,
+			Description: "Test Spec",
+			Pref:        prefix.ASCII.Fixed,
+			Tag: &TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"1": NewString(&Spec{
+					Length:      2,
+					Description: "String Field",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				"2": NewNumeric(&Spec{
+					Length:        4,
+					Description:   "Optional Numeric Field",
+					Enc:           encoding.BCD,
+					Pref:          prefix.BCD.Fixed,
+					LenientDecode: true,
+				}),
+				"3": NewNumeric(&Spec{
+					Length:      2,
+					Description: "Numeric Field",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+			},
+		})
+
+		// two bytes of BCD digits that can't be decoded
+		read, err := composite.Unpack([]byte("AB\xff\xff12"))
+		require.NoError(t, err)
+		require.Equal(t, 6, read)
+
+		data := &CompositeTestData{}
+		require.NoError(t, composite.Unmarshal(data))
+
+		require.Nil(t, data.F2)
+		require.Equal(t, 12, data.F3.Value())
+		require.Len(t, composite.SkippedSubfields(), 1)
+	})
+
+	t.Run("Unpack returns error for malformed subfield that is not lenient", func(t *testing.T) {
+		composite := NewComposite(spec)
+
+		_, err := composite.Unpack([]byte("AB12XY"))
+		require.EqualError(t, err, "failed to unpack subfield 3: failed to set bytes: failed to convert into number")
+	})
+
+	t.Run("skipped subfields are reset on the next unpack", func(t *testing.T) {
+		composite := NewComposite(spec)
+
+		_, err := composite.Unpack([]byte("ABXY12"))
+		require.NoError(t, err)
+		require.Len(t, composite.SkippedSubfields(), 1)
+
+		_, err = composite.Unpack([]byte("AB3412"))
+		require.NoError(t, err)
+		require.Empty(t, composite.SkippedSubfields())
+	})
+}
+
+func TestCompositeBlockPad(t *testing.T) {
+	newSpec := func(blockPad padding.BlockPadder) *Spec {
+		return &Spec{
+			Length:      8,
+			Description: "Encrypted Data",
+			Pref:        prefix.Binary.Fixed,
+			Composite:   &CompositeOptions{BlockPad: blockPad},
+			Tag: &TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"1": NewString(&Spec{
+					Length:      2,
+					Description: "String Field",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				"3": NewNumeric(&Spec{
+					Length:      3,
+					Description: "Numeric Field",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		blockPad padding.BlockPadder
+		packed   []byte
+	}{
+		{
+			name:     "zero",
+			blockPad: padding.ZeroBlock(8),
+			packed:   []byte("AB123\x00\x00\x00"),
+		},
+		{
+			name:     "PKCS#7",
+			blockPad: padding.PKCS7Block(8),
+			packed:   []byte("AB123\x03\x03\x03"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			composite := NewComposite(newSpec(tt.blockPad))
+			require.NoError(t, composite.Marshal(&CompositeTestData{
+				F1: NewStringValue("AB"),
+				F3: NewNumericValue(123),
+			}))
+
+			packed, err := composite.Pack()
+			require.NoError(t, err)
+			require.Equal(t, tt.packed, packed)
+
+			composite = NewComposite(newSpec(tt.blockPad))
+			read, err := composite.Unpack(packed)
+			require.NoError(t, err)
+			require.Equal(t, 8, read)
+
+			data := &CompositeTestData{}
+			require.NoError(t, composite.Unmarshal(data))
+			require.Equal(t, "AB", data.F1.Value())
+			require.Equal(t, 123, data.F3.Value())
+		})
+	}
+
+	t.Run("Unpack returns error for invalid padding", func(t *testing.T) {
+		composite := NewComposite(newSpec(padding.PKCS7Block(8)))
+
+		_, err := composite.Unpack([]byte("AB123\x03\x02\x03"))
+		require.EqualError(t, err, "failed to unpad composite: invalid padding byte 0x02")
+	})
+}
+
+func TestCompositeDisallowUnknownSubfields(t *testing.T) {
+	type data struct {
+		Known   *String `index:"1"`
+		Unknown *String `index:"99"`
+	}
+
+	newSpec := func(strict bool) *Spec {
+		return &Spec{
+			Length:      2,
+			Description: "Test Spec",
+			Pref:        prefix.ASCII.Fixed,
+			Composite:   &CompositeOptions{DisallowUnknownSubfields: strict},
+			Tag: &TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"1": NewString(&Spec{
+					Length:      2,
+					Description: "String Field",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+			},
+		}
+	}
+
+	t.Run("unknown index is ignored by default", func(t *testing.T) {
+		composite := NewComposite(newSpec(false))
+		require.NoError(t, composite.Marshal(&data{
+			Known:   NewStringValue("AB"),
+			Unknown: NewStringValue("CD"),
+		}))
+
+		_, err := composite.Unpack([]byte("AB"))
+		require.NoError(t, err)
+
+		got := &data{}
+		require.NoError(t, composite.Unmarshal(got))
+		require.Equal(t, "AB", got.Known.Value())
+		require.Nil(t, got.Unknown)
+	})
+
+	t.Run("Unmarshal returns error for unknown index", func(t *testing.T) {
+		composite := NewComposite(newSpec(true))
+
+		_, err := composite.Unpack([]byte("AB"))
+		require.NoError(t, err)
+
+		err = composite.Unmarshal(&data{})
+		require.EqualError(t, err, "failed to get data from field 99: no specification found")
+	})
+
+	t.Run("Marshal returns error for unknown index", func(t *testing.T) {
+		composite := NewComposite(newSpec(true))
+
+		err := composite.Marshal(&data{
+			Known: NewStringValue("AB"),
+		})
+		require.EqualError(t, err, "failed to set data from field 99: no specification found")
+	})
+}
+
+func TestCompositeWithGreedySubfield(t *testing.T) {
+	spec := &Spec{
+		Length:      20,
+		Description: "Additional Data",
+		Pref:        prefix.ASCII.LL,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"1": NewString(&Spec{
+				Length:      2,
+				Description: "Type",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"2": NewString(&Spec{
+				Length:      18,
+				Description: "Free Text",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.Greedy,
+			}),
+		},
+	}
+
+	composite := NewComposite(spec)
+	read, err := composite.Unpack([]byte("09ABfree textXYZ"))
+	require.NoError(t, err)
+	require.Equal(t, 11, read)
+
+	data := &CompositeTestData{}
+	require.NoError(t, composite.Unmarshal(data))
+	require.Equal(t, "AB", data.F1.Value())
+	require.Equal(t, "free te", data.F2.Value())
+
+	packed, err := composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "09ABfree te", string(packed))
+}
+
+func TestCompositeAlignment(t *testing.T) {
+	newSpec := func(align CompositeAlign, fill byte) *Spec {
+		return &Spec{
+			Length:      10,
+			Description: "Additional Data",
+			Pref:        prefix.ASCII.Fixed,
+			Tag: &TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"1": NewString(&Spec{
+					Length:      2,
+					Description: "Type",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				"2": NewString(&Spec{
+					Length:      5,
+					Description: "Value",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.LL,
+				}),
+			},
+			Composite: &CompositeOptions{
+				Align: align,
+				Fill:  fill,
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		align  CompositeAlign
+		fill   byte
+		f1, f2 string
+		packed string
+	}{
+		{"left-aligned", CompositeAlignLeft, '*', "AB", "XYZ", "AB03XYZ***"},
+		{"right-aligned", CompositeAlignRight, '*', "AB", "XYZ", "***AB03XYZ"},
+		{"left-aligned with fill byte at the end of subfield", CompositeAlignLeft, ' ', "AB", "XY ", "AB03XY    "},
+		{"right-aligned with fill byte inside subfields", CompositeAlignRight, '0', "50", "0YZ", "00050030YZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := newSpec(tt.align, tt.fill)
+
+			composite := NewComposite(spec)
+			require.NoError(t, composite.Marshal(&CompositeTestData{
+				F1: NewStringValue(tt.f1),
+				F2: NewStringValue(tt.f2),
+			}))
+
+			packed, err := composite.Pack()
+			require.NoError(t, err)
+			require.Equal(t, tt.packed, string(packed))
+
+			composite = NewComposite(spec)
+			read, err := composite.Unpack(packed)
+			require.NoError(t, err)
+			require.Equal(t, 10, read)
+
+			data := &CompositeTestData{}
+			require.NoError(t, composite.Unmarshal(data))
+			require.Equal(t, tt.f1, data.F1.Value())
+			require.Equal(t, tt.f2, data.F2.Value())
+		})
+	}
+
+	t.Run("Unpack returns error if the rest of data is not fill", func(t *testing.T) {
+		composite := NewComposite(newSpec(CompositeAlignLeft, '*'))
+
+		_, err := composite.Unpack([]byte("AB03XYZ*#*"))
+		require.EqualError(t, err, "data length: 9 does not match aggregate data read from decoded subfields: 7")
+	})
+
+	t.Run("tagged subfields are unpacked until the fill", func(t *testing.T) {
+		spec := &Spec{
+			Length:      12,
+			Description: "Additional Data",
+			Pref:        prefix.ASCII.Fixed,
+			Tag: &TagSpec{
+				Length: 2,
+				Enc:    encoding.ASCII,
+				Sort:   sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"01": NewString(&Spec{
+					Length:      5,
+					Description: "Value",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.LL,
+				}),
+			},
+			Composite: &CompositeOptions{
+				Align: CompositeAlignLeft,
+				Fill:  ' ',
+			},
+		}
+
+		composite := NewComposite(spec)
+		require.NoError(t, composite.SetData(&struct {
+			F01 *String
+		}{
+			F01: NewStringValue("XY "),
+		}))
+
+		packed, err := composite.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "0103XY      ", string(packed))
+
+		composite = NewComposite(spec)
+		read, err := composite.Unpack(packed)
+		require.NoError(t, err)
+		require.Equal(t, 12, read)
+
+		value, err := composite.GetSubfields()["01"].String()
+		require.NoError(t, err)
+		require.Equal(t, "XY ", value)
+	})
+}
+
+func TestCompositeSubfieldValidator(t *testing.T) {
+	spec := &Spec{
+		Length:      6,
+		Description: "Composite",
+		Pref:        prefix.ASCII.Fixed,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"1": NewString(&Spec{
+				Length:      2,
+				Description: "Type",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"2": NewNumeric(&Spec{
+				Length:      4,
+				Description: "Percent",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+				Validator: func(data []byte) error {
+					if n, _ := strconv.Atoi(string(data)); n > 100 {
+						return fmt.Errorf("value %s is out of range", data)
+					}
+					return nil
+				},
+			}),
+		},
+	}
+
+	composite := NewComposite(spec)
+	_, err := composite.Unpack([]byte("AB0050"))
+	require.NoError(t, err)
+
+	_, err = composite.Unpack([]byte("AB0150"))
+	require.EqualError(t, err, "failed to unpack subfield 2: field validation failed: value 150 is out of range")
+
+	composite = NewComposite(spec)
+	require.NoError(t, composite.SetData(&struct {
+		F1 *String
+		F2 *Numeric
+	}{
+		F1: NewStringValue("AB"),
+		F2: NewNumericValue(150),
+	}))
+
+	_, err = composite.Pack()
+	require.EqualError(t, err, "failed to pack subfield 2: field validation failed: value 150 is out of range")
+}
+
+func TestCompositeMaxDepth(t *testing.T) {
+	// nestedSpec returns a spec of composite nested levels deep holding a
+	// single digit at the innermost level
+	var nestedSpec func(levels int) *Spec
+	nestedSpec = func(levels int) *Spec {
+		subfield := Field(NewString(&Spec{
+			Length:      1,
+			Description: "Digit",
+			Enc:         encoding.ASCII,
+			Pref:        prefix.ASCII.Fixed,
+		}))
+		if levels > 1 {
+			subfield = NewComposite(nestedSpec(levels - 1))
+		}
+
+		return &Spec{
+			Length:      1,
+			Description: "Nested",
+			Pref:        prefix.ASCII.Fixed,
+			Tag: &TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]Field{
+				"1": subfield,
+			},
+		}
+	}
+
+	spec := nestedSpec(2)
+	spec.Composite = &CompositeOptions{MaxDepth: 2}
+
+	composite := NewComposite(spec)
+	read, err := composite.Unpack([]byte("7"))
+	require.NoError(t, err)
+	require.Equal(t, 1, read)
+
+	spec = nestedSpec(3)
+	spec.Composite = &CompositeOptions{MaxDepth: 2}
+
+	composite = NewComposite(spec)
+	_, err = composite.Unpack([]byte("7"))
+	require.EqualError(t, err, "composite nesting exceeds max depth 2")
+
+	err = composite.Marshal(&CompositeTestData{})
+	require.EqualError(t, err, "composite nesting exceeds max depth 2")
+
+	t.Run("default max depth is used when it's not set", func(t *testing.T) {
+		composite := NewComposite(nestedSpec(DefaultMaxCompositeDepth))
+		_, err := composite.Unpack([]byte("7"))
+		require.NoError(t, err)
+
+		composite = NewComposite(nestedSpec(DefaultMaxCompositeDepth + 1))
+		_, err = composite.Unpack([]byte("7"))
+		require.EqualError(t, err, fmt.Sprintf("composite nesting exceeds max depth %d", DefaultMaxCompositeDepth))
+	})
+}
+
 func TestCompositeHandlesValidSpecs(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -1582,6 +2122,22 @@ func TestCompositePanicsOnSpecValidationFailures(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "panics on alignment being defined in spec with variable length prefixer",
+			err:  "Composite spec only supports alignment with a fixed length prefixer",
+			spec: &Spec{
+				Length:    6,
+				Pref:      prefix.ASCII.LL,
+				Subfields: map[string]Field{},
+				Tag: &TagSpec{
+					Sort: sort.StringsByInt,
+				},
+				Composite: &CompositeOptions{
+					Align: CompositeAlignLeft,
+					Fill:  ' ',
+				},
+			},
+		},
 		{
 			desc: "panics on no Tag and no Bitmap being defined in spec",
 			err:  "Composite spec only supports a definition of Bitmap or Tag, can't stand both or neither",
@@ -1707,6 +2263,108 @@ func TestCompositePanicsOnSpecValidationFailures(t *testing.T) {
 	}
 }
 
+func TestCompositeJSONAliases(t *testing.T) {
+	spec := &Spec{
+		Length:      18,
+		Description: "Card Details",
+		Pref:        prefix.ASCII.Fixed,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Composite: &CompositeOptions{
+			JSONAliases: map[string]string{
+				"1": "pan",
+				"2": "amount",
+			},
+		},
+		Subfields: map[string]Field{
+			"1": NewString(&Spec{
+				Length:      10,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"2": NewNumeric(&Spec{
+				Length:      6,
+				Description: "Amount",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+			"3": NewString(&Spec{
+				Length:      2,
+				Description: "Entry Mode",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		},
+	}
+
+	composite := NewComposite(spec)
+	_, err := composite.Unpack([]byte("400000000200012305"))
+	require.NoError(t, err)
+
+	b, err := composite.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{"3":"05","amount":123,"pan":"4000000002"}`, string(b))
+
+	composite = NewComposite(spec)
+	require.NoError(t, composite.UnmarshalJSON(b))
+
+	packed, err := composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "400000000200012305", string(packed))
+
+	err = NewComposite(spec).UnmarshalJSON([]byte(`{"cvv":"123"}`))
+	require.EqualError(t, err, "failed to unmarshal subfield cvv: received subfield not defined in spec")
+}
+
+func TestCompositeZeroIsAbsent(t *testing.T) {
+	spec := &Spec{
+		Length:      99,
+		Description: "Amounts",
+		Pref:        prefix.ASCII.LL,
+		Tag: &TagSpec{
+			Length: 2,
+			Enc:    encoding.ASCII,
+			Sort:   sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"01": NewString(&Spec{
+				Length:      3,
+				Description: "Currency Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"02": NewNumeric(&Spec{
+				Length:      6,
+				Description: "Cashback Amount",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+				Numeric:     &NumericOptions{ZeroIsAbsent: true},
+			}),
+		},
+	}
+
+	composite := NewComposite(spec)
+	require.NoError(t, composite.UnmarshalJSON([]byte(`{"01":"840","02":0}`)))
+
+	packed, err := composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "0501840", string(packed))
+
+	b, err := composite.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{"01":"840"}`, string(b))
+
+	require.NoError(t, composite.UnmarshalJSON([]byte(`{"02":150}`)))
+
+	packed, err = composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "130184002000150", string(packed))
+}
+
 func TestCompositeJSONConversion(t *testing.T) {
 	json := `{"1":"AB","3":12,"11":{"1":"YZ"}}`
 
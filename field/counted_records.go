@@ -0,0 +1,253 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/utils"
+)
+
+var _ Field = (*CountedRecords)(nil)
+var _ json.Marshaler = (*CountedRecords)(nil)
+var _ json.Unmarshaler = (*CountedRecords)(nil)
+
+// maxRecordsCount is the maximum number of records that fits into the one
+// byte count
+const maxRecordsCount = 0xFF
+
+// CountedRecordsOptions defines options of the CountedRecords field type.
+type CountedRecordsOptions struct {
+	// RecordSize defines the size of each record.
+	RecordSize int
+}
+
+// CountedRecords is a field holding a one byte count followed by that many
+// records of CountedRecordsOptions.RecordSize bytes each. The spec Enc and Pref are applied to
+// the whole content (count and records) the same way as for Binary field.
+type CountedRecords struct {
+	records [][]byte
+	spec    *Spec
+	data    *CountedRecords
+}
+
+func NewCountedRecords(spec *Spec) *CountedRecords {
+	return &CountedRecords{
+		spec: spec,
+	}
+}
+
+func NewCountedRecordsValue(records [][]byte) *CountedRecords {
+	return &CountedRecords{
+		records: records,
+	}
+}
+
+func (f *CountedRecords) Spec() *Spec {
+	return f.spec
+}
+
+func (f *CountedRecords) SetSpec(spec *Spec) {
+	f.spec = spec
+}
+
+// recordSize returns the record size defined in the spec options or 0 if
+// it's not defined.
+func (f *CountedRecords) recordSize() int {
+	if f.spec == nil || f.spec.CountedRecords == nil {
+		return 0
+	}
+	return f.spec.CountedRecords.RecordSize
+}
+
+// SetBytes parses the count and the records that follow it.
+func (f *CountedRecords) SetBytes(b []byte) error {
+	size := f.recordSize()
+	if size <= 0 {
+		return errors.New("record size should be defined for records")
+	}
+
+	if len(b) == 0 {
+		return errors.New("not enough data to read records count")
+	}
+
+	count := int(b[0])
+	if len(b)-1 < count*size {
+		return fmt.Errorf("not enough data to read %d records of %d bytes, got %d bytes", count, size, len(b)-1)
+	}
+	if len(b)-1 > count*size {
+		return fmt.Errorf("data length %d does not match %d records of %d bytes", len(b)-1, count, size)
+	}
+
+	records := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		record := make([]byte, size)
+		copy(record, b[1+i*size:])
+		records = append(records, record)
+	}
+
+	f.records = records
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
+}
+
+// Bytes returns the count followed by the records.
+func (f *CountedRecords) Bytes() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	if len(f.records) > maxRecordsCount {
+		return nil, fmt.Errorf("number of records %d exceeds maximum %d", len(f.records), maxRecordsCount)
+	}
+
+	out := []byte{byte(len(f.records))}
+	for i, record := range f.records {
+		if f.spec != nil && len(record) != f.recordSize() {
+			return nil, fmt.Errorf("record %d length %d does not match record size %d", i, len(record), f.recordSize())
+		}
+		out = append(out, record...)
+	}
+
+	return out, nil
+}
+
+func (f *CountedRecords) String() (string, error) {
+	if f == nil {
+		return "", nil
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%X", b), nil
+}
+
+func (f *CountedRecords) Value() [][]byte {
+	if f == nil {
+		return nil
+	}
+	return f.records
+}
+
+func (f *CountedRecords) SetValue(records [][]byte) {
+	f.records = records
+}
+
+func (f *CountedRecords) Pack() ([]byte, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := f.spec.Enc.Encode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	return append(packedLength, packed...), nil
+}
+
+func (f *CountedRecords) Unpack(data []byte) (int, error) {
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	raw, read, err := f.spec.Enc.Decode(data[prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return read + prefBytes, nil
+}
+
+// Unmarshal sets the records into v which should be either
+// *CountedRecords or *[][]byte.
+func (f *CountedRecords) Unmarshal(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case *CountedRecords:
+		val.records = f.records
+	case *[][]byte:
+		*val = f.records
+	default:
+		return errors.New("data does not match required *CountedRecords or *[][]byte type")
+	}
+
+	return nil
+}
+
+// SetData sets the records from data which should be either
+// *CountedRecords or *[][]byte.
+func (f *CountedRecords) SetData(data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	switch val := data.(type) {
+	case *CountedRecords:
+		f.data = val
+		if val.records != nil {
+			f.records = val.records
+		}
+	case *[][]byte:
+		f.records = *val
+	default:
+		return errors.New("data does not match required *CountedRecords or *[][]byte type")
+	}
+
+	return nil
+}
+
+func (f *CountedRecords) Marshal(data interface{}) error {
+	return f.SetData(data)
+}
+
+// MarshalJSON returns the records as a list of HEX strings.
+func (f *CountedRecords) MarshalJSON() ([]byte, error) {
+	records := make([]string, 0, len(f.records))
+	for _, record := range f.records {
+		records = append(records, fmt.Sprintf("%X", record))
+	}
+
+	bytes, err := json.Marshal(records)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to JSON marshal records to bytes")
+	}
+	return bytes, nil
+}
+
+func (f *CountedRecords) UnmarshalJSON(b []byte) error {
+	var records []string
+	if err := json.Unmarshal(b, &records); err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to records")
+	}
+
+	f.records = make([][]byte, 0, len(records))
+	for _, record := range records {
+		raw, err := encoding.ASCIIHexToBytes.Encode([]byte(record))
+		if err != nil {
+			return utils.NewSafeError(err, "failed to convert ASCII Hex string to bytes")
+		}
+		f.records = append(f.records, raw)
+	}
+
+	return nil
+}
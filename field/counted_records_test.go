@@ -0,0 +1,71 @@
+package field
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountedRecordsField(t *testing.T) {
+	spec := &Spec{
+		Length:         255,
+		Description:    "Records",
+		Enc:            encoding.Binary,
+		Pref:           prefix.Binary.L,
+		CountedRecords: &CountedRecordsOptions{RecordSize: 2},
+	}
+
+	packed := []byte{0x07, 0x03, 'A', 'A', 'B', 'B', 'C', 'C'}
+	records := [][]byte{[]byte("AA"), []byte("BB"), []byte("CC")}
+
+	t.Run("Unpack reads count and records", func(t *testing.T) {
+		field := NewCountedRecords(spec)
+
+		read, err := field.Unpack(packed)
+		require.NoError(t, err)
+		require.Equal(t, 8, read)
+
+		var got [][]byte
+		require.NoError(t, field.Unmarshal(&got))
+		require.Equal(t, records, got)
+	})
+
+	t.Run("Pack writes count and records", func(t *testing.T) {
+		field := NewCountedRecords(spec)
+		require.NoError(t, field.Marshal(&records))
+
+		got, err := field.Pack()
+		require.NoError(t, err)
+		require.Equal(t, packed, got)
+	})
+
+	t.Run("Unpack returns error for too short data", func(t *testing.T) {
+		field := NewCountedRecords(spec)
+
+		_, err := field.Unpack([]byte{0x05, 0x03, 'A', 'A', 'B', 'B'})
+		require.EqualError(t, err, "failed to set bytes: not enough data to read 3 records of 2 bytes, got 4 bytes")
+	})
+
+	t.Run("Pack returns error for record of wrong size", func(t *testing.T) {
+		field := NewCountedRecordsValue([][]byte{[]byte("AA"), []byte("B")})
+		field.SetSpec(spec)
+
+		_, err := field.Pack()
+		require.EqualError(t, err, "record 1 length 1 does not match record size 2")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		field := NewCountedRecordsValue(records)
+
+		b, err := json.Marshal(field)
+		require.NoError(t, err)
+		require.JSONEq(t, `["4141","4242","4343"]`, string(b))
+
+		field = NewCountedRecords(spec)
+		require.NoError(t, json.Unmarshal(b, field))
+		require.Equal(t, records, field.Value())
+	})
+}
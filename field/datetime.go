@@ -0,0 +1,221 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/moov-io/iso8583/utils"
+)
+
+var _ Field = (*DateTime)(nil)
+var _ json.Marshaler = (*DateTime)(nil)
+var _ json.Unmarshaler = (*DateTime)(nil)
+
+// DateTimeOptions defines options of the DateTime field type.
+type DateTimeOptions struct {
+	// Layout defines the Go reference time layout (e.g. "0102150405" for
+	// MMDDhhmmss) used to format and parse the value.
+	Layout string
+}
+
+// DateTime is a field holding a date and/or time formatted according to the
+// DateTimeOptions.Layout, e.g. "0102" for MMDD or "060102150405" for YYMMDDhhmmss.
+// Parts of the time not present in the layout are zero (or January 1 for the
+// date parts) after unpacking, and the time is in UTC.
+type DateTime struct {
+	value time.Time
+	spec  *Spec
+	data  *DateTime
+}
+
+func NewDateTime(spec *Spec) *DateTime {
+	return &DateTime{
+		spec: spec,
+	}
+}
+
+func NewDateTimeValue(val time.Time) *DateTime {
+	return &DateTime{
+		value: val,
+	}
+}
+
+func (f *DateTime) Spec() *Spec {
+	return f.spec
+}
+
+func (f *DateTime) SetSpec(spec *Spec) {
+	f.spec = spec
+}
+
+// layout returns the layout defined in the spec options or an empty string
+// if it's not defined.
+func (f *DateTime) layout() string {
+	if f.spec == nil || f.spec.DateTime == nil {
+		return ""
+	}
+	return f.spec.DateTime.Layout
+}
+
+func (f *DateTime) SetBytes(b []byte) error {
+	layout := f.layout()
+	if layout == "" {
+		return errors.New("layout should be defined for date time")
+	}
+
+	val, err := time.Parse(layout, string(b))
+	if err != nil {
+		return utils.NewSafeError(err, fmt.Sprintf("failed to parse date time using layout %s", layout))
+	}
+
+	f.value = val
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
+}
+
+func (f *DateTime) Bytes() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	layout := f.layout()
+	if layout == "" {
+		return nil, errors.New("layout should be defined for date time")
+	}
+
+	return []byte(f.value.Format(layout)), nil
+}
+
+func (f *DateTime) String() (string, error) {
+	if f == nil {
+		return "", nil
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (f *DateTime) Value() time.Time {
+	if f == nil {
+		return time.Time{}
+	}
+	return f.value
+}
+
+func (f *DateTime) SetValue(v time.Time) {
+	f.value = v
+}
+
+func (f *DateTime) Pack() ([]byte, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := f.spec.Enc.Encode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	return append(packedLength, packed...), nil
+}
+
+// returns number of bytes was read
+func (f *DateTime) Unpack(data []byte) (int, error) {
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	raw, read, err := f.spec.Enc.Decode(data[prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return read + prefBytes, nil
+}
+
+// Unmarshal sets field value into v which should be either *DateTime or
+// *time.Time.
+func (f *DateTime) Unmarshal(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case *DateTime:
+		val.value = f.value
+	case *time.Time:
+		*val = f.value
+	default:
+		return errors.New("data does not match required *DateTime or *time.Time type")
+	}
+
+	return nil
+}
+
+// SetData sets field value from data which should be either *DateTime or
+// *time.Time.
+func (f *DateTime) SetData(data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	switch val := data.(type) {
+	case *DateTime:
+		f.data = val
+		if !val.value.IsZero() {
+			f.value = val.value
+		}
+	case *time.Time:
+		f.value = *val
+	default:
+		return errors.New("data does not match required *DateTime or *time.Time type")
+	}
+
+	return nil
+}
+
+func (f *DateTime) Marshal(data interface{}) error {
+	return f.SetData(data)
+}
+
+// MarshalJSON returns the value formatted according to the layout as a
+// JSON string.
+func (f *DateTime) MarshalJSON() ([]byte, error) {
+	data, err := f.String()
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to convert date time to string")
+	}
+
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to JSON marshal string to bytes")
+	}
+	return bytes, nil
+}
+
+func (f *DateTime) UnmarshalJSON(b []byte) error {
+	var v string
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to string")
+	}
+	return f.SetBytes([]byte(v))
+}
@@ -0,0 +1,117 @@
+package field
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/moov-io/iso8583/sort"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTimeField(t *testing.T) {
+	spec := &Spec{
+		Length:      10,
+		Description: "Transmission Date & Time",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+		DateTime:    &DateTimeOptions{Layout: "0102150405"},
+	}
+
+	t.Run("Pack renders time using the layout", func(t *testing.T) {
+		field := NewDateTime(spec)
+		tm := time.Date(2023, time.March, 7, 14, 5, 9, 0, time.UTC)
+		require.NoError(t, field.Marshal(&tm))
+
+		packed, err := field.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "0307140509", string(packed))
+	})
+
+	t.Run("Unpack parses time using the layout", func(t *testing.T) {
+		field := NewDateTime(spec)
+
+		read, err := field.Unpack([]byte("1231235959"))
+		require.NoError(t, err)
+		require.Equal(t, 10, read)
+
+		var tm time.Time
+		require.NoError(t, field.Unmarshal(&tm))
+		require.Equal(t, time.Date(0, time.December, 31, 23, 59, 59, 0, time.UTC), tm)
+	})
+
+	t.Run("Unpack returns error for malformed input", func(t *testing.T) {
+		field := NewDateTime(spec)
+
+		_, err := field.Unpack([]byte("1332235959"))
+		require.EqualError(t, err, "failed to set bytes: failed to parse date time using layout 0102150405")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		field := NewDateTime(spec)
+		field.SetValue(time.Date(2023, time.March, 7, 14, 5, 9, 0, time.UTC))
+
+		b, err := json.Marshal(field)
+		require.NoError(t, err)
+		require.Equal(t, `"0307140509"`, string(b))
+
+		field = NewDateTime(spec)
+		require.NoError(t, json.Unmarshal([]byte(`"0101000000"`), field))
+		require.Equal(t, time.January, field.Value().Month())
+	})
+}
+
+func TestDateTimeFieldInComposite(t *testing.T) {
+	type dateTimeData struct {
+		F1 *time.Time
+		F2 *DateTime
+	}
+
+	spec := &Spec{
+		Length:      10,
+		Description: "Original Data Elements",
+		Pref:        prefix.ASCII.Fixed,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"1": NewDateTime(&Spec{
+				Length:      4,
+				Description: "Date, Local Transaction",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				DateTime:    &DateTimeOptions{Layout: "0102"},
+			}),
+			"2": NewDateTime(&Spec{
+				Length:      6,
+				Description: "Time, Local Transaction",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				DateTime:    &DateTimeOptions{Layout: "150405"},
+			}),
+		},
+	}
+
+	date := time.Date(2023, time.March, 7, 0, 0, 0, 0, time.UTC)
+	composite := NewComposite(spec)
+	require.NoError(t, composite.Marshal(&dateTimeData{
+		F1: &date,
+		F2: NewDateTimeValue(time.Date(0, 1, 1, 14, 5, 9, 0, time.UTC)),
+	}))
+
+	packed, err := composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "0307140509", string(packed))
+
+	composite = NewComposite(spec)
+	_, err = composite.Unpack(packed)
+	require.NoError(t, err)
+
+	data := &dateTimeData{}
+	require.NoError(t, composite.Unmarshal(data))
+	require.Equal(t, time.March, data.F1.Month())
+	require.Equal(t, 7, data.F1.Day())
+	require.Equal(t, 14, data.F2.Value().Hour())
+}
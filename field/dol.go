@@ -0,0 +1,240 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/moov-io/iso8583/utils"
+)
+
+var _ Field = (*DOL)(nil)
+var _ json.Marshaler = (*DOL)(nil)
+var _ json.Unmarshaler = (*DOL)(nil)
+
+// DOLEntry is an entry of the EMV Data Object List: a tag (as a HEX string,
+// e.g. "9F02") and the length of its value, without the value itself.
+type DOLEntry struct {
+	Tag    string `json:"tag"`
+	Length int    `json:"length"`
+}
+
+// DOL is a field holding an EMV Data Object List (e.g. PDOL or CDOL). It's a
+// sequence of BER-TLV tags and lengths without values. The spec Enc and
+// Pref are applied to the binary list the same way as for Binary field.
+type DOL struct {
+	entries []DOLEntry
+	spec    *Spec
+	data    *DOL
+}
+
+func NewDOL(spec *Spec) *DOL {
+	return &DOL{
+		spec: spec,
+	}
+}
+
+func NewDOLValue(entries []DOLEntry) *DOL {
+	return &DOL{
+		entries: entries,
+	}
+}
+
+func (f *DOL) Spec() *Spec {
+	return f.spec
+}
+
+func (f *DOL) SetSpec(spec *Spec) {
+	f.spec = spec
+}
+
+// SetBytes parses binary tags and lengths of the list.
+func (f *DOL) SetBytes(b []byte) error {
+	var entries []DOLEntry
+
+	for off := 0; off < len(b); {
+		tag, read, err := encoding.BerTLVTag.Decode(b[off:], 0)
+		if err != nil {
+			return fmt.Errorf("failed to decode tag: %w", err)
+		}
+		off += read
+
+		length, read, err := prefix.BerTLV.DecodeLength(0, b[off:])
+		if err != nil {
+			return fmt.Errorf("failed to decode length of tag %s: %w", tag, err)
+		}
+		off += read
+
+		entries = append(entries, DOLEntry{Tag: string(tag), Length: length})
+	}
+
+	f.entries = entries
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
+}
+
+// Bytes returns binary tags and lengths of the list.
+func (f *DOL) Bytes() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	var out []byte
+	for _, entry := range f.entries {
+		tag, err := encoding.BerTLVTag.Encode([]byte(entry.Tag))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tag %s: %w", entry.Tag, err)
+		}
+
+		length := []byte{0}
+		if entry.Length > 0 {
+			length, err = prefix.BerTLV.EncodeLength(0, entry.Length)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode length of tag %s: %w", entry.Tag, err)
+			}
+		}
+
+		out = append(out, tag...)
+		out = append(out, length...)
+	}
+
+	return out, nil
+}
+
+func (f *DOL) String() (string, error) {
+	if f == nil {
+		return "", nil
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%X", b), nil
+}
+
+func (f *DOL) Value() []DOLEntry {
+	if f == nil {
+		return nil
+	}
+	return f.entries
+}
+
+func (f *DOL) SetValue(entries []DOLEntry) {
+	f.entries = entries
+}
+
+func (f *DOL) Pack() ([]byte, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
+		data = f.spec.Pad.Pad(data, f.spec.Length)
+	}
+
+	packed, err := f.spec.Enc.Encode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	return append(packedLength, packed...), nil
+}
+
+func (f *DOL) Unpack(data []byte) (int, error) {
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	raw, read, err := f.spec.Enc.Decode(data[prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	if f.spec.Pad != nil {
+		raw = f.spec.Pad.Unpad(raw)
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return read + prefBytes, nil
+}
+
+// Unmarshal sets the list into v which should be either *DOL or
+// *[]DOLEntry.
+func (f *DOL) Unmarshal(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case *DOL:
+		val.entries = f.entries
+	case *[]DOLEntry:
+		*val = f.entries
+	default:
+		return errors.New("data does not match required *DOL or *[]DOLEntry type")
+	}
+
+	return nil
+}
+
+// SetData sets the list from data which should be either *DOL or
+// *[]DOLEntry.
+func (f *DOL) SetData(data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	switch val := data.(type) {
+	case *DOL:
+		f.data = val
+		if val.entries != nil {
+			f.entries = val.entries
+		}
+	case *[]DOLEntry:
+		f.entries = *val
+	default:
+		return errors.New("data does not match required *DOL or *[]DOLEntry type")
+	}
+
+	return nil
+}
+
+func (f *DOL) Marshal(data interface{}) error {
+	return f.SetData(data)
+}
+
+func (f *DOL) MarshalJSON() ([]byte, error) {
+	bytes, err := json.Marshal(f.entries)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to JSON marshal DOL entries to bytes")
+	}
+	return bytes, nil
+}
+
+func (f *DOL) UnmarshalJSON(b []byte) error {
+	var entries []DOLEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to DOL entries")
+	}
+
+	f.entries = entries
+	return nil
+}
@@ -0,0 +1,71 @@
+package field
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDOLField(t *testing.T) {
+	spec := &Spec{
+		Length:      252,
+		Description: "Data Object List",
+		Enc:         encoding.Binary,
+		Pref:        prefix.Binary.L,
+	}
+
+	// 9F02 06 9F03 06 95 05
+	packed := []byte{0x08, 0x9F, 0x02, 0x06, 0x9F, 0x03, 0x06, 0x95, 0x05}
+	entries := []DOLEntry{
+		{Tag: "9F02", Length: 6},
+		{Tag: "9F03", Length: 6},
+		{Tag: "95", Length: 5},
+	}
+
+	t.Run("Unpack parses tags and lengths", func(t *testing.T) {
+		dol := NewDOL(spec)
+
+		read, err := dol.Unpack(packed)
+		require.NoError(t, err)
+		require.Equal(t, len(packed), read)
+
+		var got []DOLEntry
+		require.NoError(t, dol.Unmarshal(&got))
+		require.Equal(t, entries, got)
+
+		str, err := dol.String()
+		require.NoError(t, err)
+		require.Equal(t, "9F02069F03069505", str)
+	})
+
+	t.Run("Pack packs tags and lengths", func(t *testing.T) {
+		dol := NewDOL(spec)
+		require.NoError(t, dol.Marshal(&entries))
+
+		got, err := dol.Pack()
+		require.NoError(t, err)
+		require.Equal(t, packed, got)
+	})
+
+	t.Run("Unpack returns error for truncated list", func(t *testing.T) {
+		dol := NewDOL(spec)
+
+		_, err := dol.Unpack([]byte{0x02, 0x9F, 0x02})
+		require.EqualError(t, err, "failed to set bytes: failed to decode length of tag 9F02: failed to decode TLV length: EOF")
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		dol := NewDOLValue(entries)
+
+		b, err := json.Marshal(dol)
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"tag":"9F02","length":6},{"tag":"9F03","length":6},{"tag":"95","length":5}]`, string(b))
+
+		dol = NewDOL(spec)
+		require.NoError(t, json.Unmarshal(b, dol))
+		require.Equal(t, entries, dol.Value())
+	})
+}
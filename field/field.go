@@ -37,3 +37,19 @@ type Field interface {
 	// String returns a string representation of the field Value
 	String() (string, error)
 }
+
+// AbsentChecker is implemented by fields whose set value may be treated as
+// not set, e.g. a Numeric field with NumericOptions.ZeroIsAbsent holding
+// zero. Messages and composites neither pack such fields nor include them
+// into the bitmap or JSON.
+type AbsentChecker interface {
+	// IsAbsent returns true if the field value should be treated as not
+	// set.
+	IsAbsent() bool
+}
+
+// isAbsent returns true if the field value should be treated as not set.
+func isAbsent(f Field) bool {
+	absent, ok := f.(AbsentChecker)
+	return ok && absent.IsAbsent()
+}
@@ -0,0 +1,219 @@
+package field
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/moov-io/iso8583/utils"
+)
+
+var _ Field = (*Flag)(nil)
+var _ json.Marshaler = (*Flag)(nil)
+var _ json.Unmarshaler = (*Flag)(nil)
+
+const (
+	defaultFlagTrue  = "Y"
+	defaultFlagFalse = "N"
+)
+
+// FlagOptions defines options of the Flag field type.
+type FlagOptions struct {
+	// TrueValue and FalseValue define the representations of true and
+	// false values, e.g. "1" and "0". When not set, "Y" and "N" are used.
+	TrueValue  string
+	FalseValue string
+}
+
+// Flag is an indicator field holding a boolean value represented by the
+// FlagOptions.TrueValue and FlagOptions.FalseValue (Y/N by default).
+type Flag struct {
+	value bool
+	spec  *Spec
+	data  *Flag
+}
+
+func NewFlag(spec *Spec) *Flag {
+	return &Flag{
+		spec: spec,
+	}
+}
+
+func NewFlagValue(val bool) *Flag {
+	return &Flag{
+		value: val,
+	}
+}
+
+func (f *Flag) Spec() *Spec {
+	return f.spec
+}
+
+func (f *Flag) SetSpec(spec *Spec) {
+	f.spec = spec
+}
+
+func (f *Flag) SetBytes(b []byte) error {
+	trueValue, falseValue := f.representations()
+
+	switch string(b) {
+	case trueValue:
+		f.value = true
+	case falseValue:
+		f.value = false
+	default:
+		return fmt.Errorf("invalid flag value %q, expected %q or %q", b, trueValue, falseValue)
+	}
+
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
+}
+
+func (f *Flag) Bytes() ([]byte, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	trueValue, falseValue := f.representations()
+	if f.value {
+		return []byte(trueValue), nil
+	}
+	return []byte(falseValue), nil
+}
+
+func (f *Flag) String() (string, error) {
+	if f == nil {
+		return "", nil
+	}
+
+	b, err := f.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (f *Flag) Value() bool {
+	if f == nil {
+		return false
+	}
+	return f.value
+}
+
+func (f *Flag) SetValue(v bool) {
+	f.value = v
+}
+
+func (f *Flag) Pack() ([]byte, error) {
+	data, err := f.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := f.spec.Enc.Encode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode content: %w", err)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode length: %w", err)
+	}
+
+	return append(packedLength, packed...), nil
+}
+
+// returns number of bytes was read
+func (f *Flag) Unpack(data []byte) (int, error) {
+	dataLen, prefBytes, err := f.spec.Pref.DecodeLength(f.spec.Length, data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode length: %w", err)
+	}
+
+	raw, read, err := f.spec.Enc.Decode(data[prefBytes:], dataLen)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	if err := f.SetBytes(raw); err != nil {
+		return 0, fmt.Errorf("failed to set bytes: %w", err)
+	}
+
+	return read + prefBytes, nil
+}
+
+// Unmarshal sets field value into v which should be either *Flag or *bool.
+func (f *Flag) Unmarshal(v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	switch val := v.(type) {
+	case *Flag:
+		val.value = f.value
+	case *bool:
+		*val = f.value
+	default:
+		return errors.New("data does not match required *Flag or *bool type")
+	}
+
+	return nil
+}
+
+// SetData sets field value from data which should be either *Flag or *bool.
+func (f *Flag) SetData(data interface{}) error {
+	if data == nil {
+		return nil
+	}
+
+	switch val := data.(type) {
+	case *Flag:
+		f.data = val
+		f.value = val.value
+	case *bool:
+		f.value = *val
+	default:
+		return errors.New("data does not match required *Flag or *bool type")
+	}
+
+	return nil
+}
+
+func (f *Flag) Marshal(data interface{}) error {
+	return f.SetData(data)
+}
+
+func (f *Flag) MarshalJSON() ([]byte, error) {
+	bytes, err := json.Marshal(f.value)
+	if err != nil {
+		return nil, utils.NewSafeError(err, "failed to JSON marshal bool to bytes")
+	}
+	return bytes, nil
+}
+
+func (f *Flag) UnmarshalJSON(b []byte) error {
+	var v bool
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to bool")
+	}
+	f.value = v
+	return nil
+}
+
+func (f *Flag) representations() (string, string) {
+	trueValue, falseValue := defaultFlagTrue, defaultFlagFalse
+	if f.spec == nil || f.spec.Flag == nil {
+		return trueValue, falseValue
+	}
+
+	if f.spec.Flag.TrueValue != "" {
+		trueValue = f.spec.Flag.TrueValue
+	}
+	if f.spec.Flag.FalseValue != "" {
+		falseValue = f.spec.Flag.FalseValue
+	}
+	return trueValue, falseValue
+}
@@ -0,0 +1,72 @@
+package field
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagField(t *testing.T) {
+	spec := &Spec{
+		Length:      1,
+		Description: "Partial Approval Indicator",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+	}
+
+	t.Run("Pack uses Y/N by default", func(t *testing.T) {
+		flag := NewFlagValue(true)
+		flag.SetSpec(spec)
+
+		packed, err := flag.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "Y", string(packed))
+
+		flag.SetValue(false)
+		packed, err = flag.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "N", string(packed))
+	})
+
+	t.Run("Unpack uses configured representations", func(t *testing.T) {
+		flag := NewFlag(&Spec{
+			Length: 1,
+			Enc:    encoding.ASCII,
+			Pref:   prefix.ASCII.Fixed,
+			Flag:   &FlagOptions{TrueValue: "1", FalseValue: "0"},
+		})
+
+		read, err := flag.Unpack([]byte("1"))
+		require.NoError(t, err)
+		require.Equal(t, 1, read)
+		require.True(t, flag.Value())
+
+		var value bool
+		_, err = flag.Unpack([]byte("0"))
+		require.NoError(t, err)
+		require.NoError(t, flag.Unmarshal(&value))
+		require.False(t, value)
+	})
+
+	t.Run("Unpack returns error for unknown representation", func(t *testing.T) {
+		flag := NewFlag(spec)
+
+		_, err := flag.Unpack([]byte("X"))
+		require.EqualError(t, err, `failed to set bytes: invalid flag value "X", expected "Y" or "N"`)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		flag := NewFlagValue(true)
+
+		b, err := json.Marshal(flag)
+		require.NoError(t, err)
+		require.Equal(t, "true", string(b))
+
+		flag = NewFlag(spec)
+		require.NoError(t, json.Unmarshal([]byte("true"), flag))
+		require.True(t, flag.Value())
+	})
+}
@@ -87,7 +87,14 @@ func (f *Hex) Pack() ([]byte, error) {
 		return nil, utils.NewSafeErrorf(err, "converting hex field into bytes")
 	}
 
+	if err := validate(f.spec, data); err != nil {
+		return nil, err
+	}
+
 	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
 		data = f.spec.Pad.Pad(data, f.spec.Length)
 	}
 
@@ -119,6 +126,10 @@ func (f *Hex) Unpack(data []byte) (int, error) {
 		raw = f.spec.Pad.Unpad(raw)
 	}
 
+	if err := validate(f.spec, raw); err != nil {
+		return 0, err
+	}
+
 	if err := f.SetBytes(raw); err != nil {
 		return 0, fmt.Errorf("failed to set bytes: %w", err)
 	}
@@ -0,0 +1,36 @@
+package field
+
+import "errors"
+
+// ErrLuhnCheck is returned when digits of a numeric field with Luhn option
+// enabled don't pass the Luhn checksum.
+var ErrLuhnCheck = errors.New("luhn check failed")
+
+// luhnValid reports whether digits pass the Luhn (mod 10) checksum.
+// Any character other than a digit makes the checksum invalid.
+func luhnValid(digits []byte) bool {
+	if len(digits) == 0 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
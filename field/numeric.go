@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/moov-io/iso8583/utils"
 )
@@ -12,11 +14,40 @@ import (
 var _ Field = (*Numeric)(nil)
 var _ json.Marshaler = (*Numeric)(nil)
 var _ json.Unmarshaler = (*Numeric)(nil)
+var _ AbsentChecker = (*Numeric)(nil)
+
+// NumericOptions defines options of the Numeric field type.
+type NumericOptions struct {
+	// DurationUnit defines the unit of the field value when it's marshaled
+	// from or unmarshaled into time.Duration, e.g. time.Second or
+	// time.Millisecond. When not set, seconds are used.
+	DurationUnit time.Duration
+	// ZeroIsAbsent makes a message field or composite subfield with zero
+	// value to be treated as not set: it's neither packed nor included in
+	// the bitmap or JSON (see AbsentChecker). By default,
+	// zero is a real value and is packed (e.g. as "000").
+	ZeroIsAbsent bool
+	// LeadingSymbols defines a set of characters (e.g. currency symbols
+	// "$€") that are stripped from the beginning of the value before it's
+	// converted into a number. Stripped symbols are not packed back.
+	LeadingSymbols string
+	// KeepDigits makes the field keep the unpacked digits as they are
+	// instead of converting them into int, so values that don't fit into
+	// int (e.g. long account numbers) are preserved. The digits are
+	// available via Digits and are packed back unchanged.
+	KeepDigits bool
+	// Luhn makes the field (e.g. a PAN) verify its digits with the Luhn
+	// checksum on Unpack, Marshal and Pack. Failures are reported as
+	// ErrLuhnCheck.
+	Luhn bool
+}
 
 type Numeric struct {
 	value int
-	spec  *Spec
-	data  *Numeric
+	// digits holds the value as is when NumericOptions.KeepDigits is set
+	digits string
+	spec   *Spec
+	data   *Numeric
 }
 
 func NewNumeric(spec *Spec) *Numeric {
@@ -39,19 +70,39 @@ func (f *Numeric) SetSpec(spec *Spec) {
 	f.spec = spec
 }
 
+// options returns the numeric options of the spec or zero options if they
+// are not defined.
+func (f *Numeric) options() NumericOptions {
+	if f.spec == nil || f.spec.Numeric == nil {
+		return NumericOptions{}
+	}
+	return *f.spec.Numeric
+}
+
 func (f *Numeric) SetBytes(b []byte) error {
 	if len(b) == 0 {
 		// for a length 0 raw, string(raw) would become "" which makes Atoi return an error
 		// however for example "0000" (value 0 left-padded with '0') should have 0 as output, not an error
 		// so if the length of raw is 0, set f.value to 0 instead of parsing the raw
 		f.value = 0
+		f.digits = ""
 	} else {
+		raw := string(b)
+		if symbols := f.options().LeadingSymbols; symbols != "" {
+			raw = strings.TrimLeft(raw, symbols)
+		}
+
+		if f.options().KeepDigits {
+			return f.setDigits(raw)
+		}
+
 		// otherwise parse the raw to an int
-		val, err := strconv.Atoi(string(b))
+		val, err := strconv.Atoi(raw)
 		if err != nil {
 			return utils.NewSafeError(err, "failed to convert into number")
 		}
 		f.value = val
+		f.digits = ""
 	}
 
 	if f.data != nil {
@@ -64,14 +115,53 @@ func (f *Numeric) Bytes() ([]byte, error) {
 	if f == nil {
 		return nil, nil
 	}
-	return []byte(strconv.Itoa(f.value)), nil
+	return []byte(f.Digits()), nil
 }
 
 func (f *Numeric) String() (string, error) {
 	if f == nil {
 		return "", nil
 	}
-	return strconv.Itoa(f.value), nil
+	return f.Digits(), nil
+}
+
+// Digits returns the value as a string of digits. When
+// NumericOptions.KeepDigits is set, these are the digits unpacked or set by
+// SetDigits, even if they don't fit into int.
+func (f *Numeric) Digits() string {
+	if f == nil {
+		return ""
+	}
+	if f.digits != "" {
+		return f.digits
+	}
+	return strconv.Itoa(f.value)
+}
+
+// SetDigits sets the value from a string of digits without converting it
+// into int, so it's packed unchanged. Value returns 0 if the digits don't
+// fit into int.
+func (f *Numeric) SetDigits(digits string) error {
+	return f.setDigits(digits)
+}
+
+func (f *Numeric) setDigits(digits string) error {
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return fmt.Errorf("invalid digit %q at position %d", digits[i], i)
+		}
+	}
+
+	f.digits = digits
+	f.value = 0
+	if val, err := strconv.Atoi(digits); err == nil {
+		f.value = val
+	}
+
+	if f.data != nil {
+		*(f.data) = *f
+	}
+	return nil
 }
 
 func (f *Numeric) Value() int {
@@ -83,12 +173,24 @@ func (f *Numeric) Value() int {
 
 func (f *Numeric) SetValue(v int) {
 	f.value = v
+	f.digits = ""
 }
 
 func (f *Numeric) Pack() ([]byte, error) {
-	data := []byte(strconv.Itoa(f.value))
+	data := []byte(f.Digits())
+
+	if err := validate(f.spec, data); err != nil {
+		return nil, err
+	}
+
+	if err := f.checkLuhn(data); err != nil {
+		return nil, err
+	}
 
 	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
 		data = f.spec.Pad.Pad(data, f.spec.Length)
 	}
 
@@ -121,6 +223,14 @@ func (f *Numeric) Unpack(data []byte) (int, error) {
 		raw = f.spec.Pad.Unpad(raw)
 	}
 
+	if err := validate(f.spec, raw); err != nil {
+		return 0, err
+	}
+
+	if err := f.checkLuhn(raw); err != nil {
+		return 0, err
+	}
+
 	if err := f.SetBytes(raw); err != nil {
 		return 0, fmt.Errorf("failed to set bytes: %w", err)
 	}
@@ -128,42 +238,98 @@ func (f *Numeric) Unpack(data []byte) (int, error) {
 	return read + prefBytes, nil
 }
 
+// Unmarshal sets field value into v which should be either *Numeric or
+// *time.Duration. For *time.Duration the value is multiplied by the
+// NumericOptions.DurationUnit.
 func (f *Numeric) Unmarshal(v interface{}) error {
 	if v == nil {
 		return nil
 	}
-	num, ok := v.(*Numeric)
-	if !ok {
+
+	switch val := v.(type) {
+	case *Numeric:
+		val.value = f.value
+		val.digits = f.digits
+	case *time.Duration:
+		*val = time.Duration(f.value) * f.durationUnit()
+	default:
 		return errors.New("data does not match required *Numeric type")
 	}
 
-	num.value = f.value
-
 	return nil
 }
 
+// SetData sets field value from data which should be either *Numeric or
+// *time.Duration. For *time.Duration the value is the number of
+// NumericOptions.DurationUnit units in the duration.
 func (f *Numeric) SetData(data interface{}) error {
 	if data == nil {
 		return nil
 	}
 
-	num, ok := data.(*Numeric)
-	if !ok {
+	switch val := data.(type) {
+	case *Numeric:
+		f.data = val
+		if val.value != 0 || val.digits != "" {
+			f.value = val.value
+			f.digits = val.digits
+		}
+	case *time.Duration:
+		f.SetValue(int(*val / f.durationUnit()))
+	default:
 		return fmt.Errorf("data does not match required *Numeric type")
 	}
 
-	f.data = num
-	if num.value != 0 {
-		f.value = num.value
-	}
 	return nil
 }
 
 func (f *Numeric) Marshal(data interface{}) error {
-	return f.SetData(data)
+	if err := f.SetData(data); err != nil {
+		return err
+	}
+
+	if f.spec != nil {
+		return f.checkLuhn([]byte(f.Digits()))
+	}
+
+	return nil
+}
+
+// checkLuhn returns ErrLuhnCheck when NumericOptions.Luhn is enabled and digits don't
+// pass the Luhn checksum.
+func (f *Numeric) checkLuhn(digits []byte) error {
+	if !f.options().Luhn || luhnValid(digits) {
+		return nil
+	}
+
+	return ErrLuhnCheck
+}
+
+func (f *Numeric) durationUnit() time.Duration {
+	if unit := f.options().DurationUnit; unit != 0 {
+		return unit
+	}
+	return time.Second
+}
+
+// IsAbsent returns true if NumericOptions.ZeroIsAbsent is set and the value
+// is zero. Digits are checked as kept digits (NumericOptions.KeepDigits)
+// that don't fit into int have zero value.
+func (f *Numeric) IsAbsent() bool {
+	return f.options().ZeroIsAbsent && strings.Trim(f.Digits(), "0") == ""
 }
 
 func (f *Numeric) MarshalJSON() ([]byte, error) {
+	if f.digits != "" {
+		// keep all digits by marshaling them as a number literal which
+		// can't have leading zeros
+		digits := strings.TrimLeft(f.digits, "0")
+		if digits == "" {
+			digits = "0"
+		}
+		return []byte(digits), nil
+	}
+
 	bytes, err := json.Marshal(f.value)
 	if err != nil {
 		return nil, utils.NewSafeError(err, "failed to JSON marshal int to bytes")
@@ -171,11 +337,13 @@ func (f *Numeric) MarshalJSON() ([]byte, error) {
 	return bytes, nil
 }
 
+// UnmarshalJSON decodes the value from a JSON number or a string holding a
+// number. The number is set as is via SetBytes, so the same path serves both
+// int values and digits kept with NumericOptions.KeepDigits.
 func (f *Numeric) UnmarshalJSON(b []byte) error {
-	var v int
-	err := json.Unmarshal(b, &v)
-	if err != nil {
-		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to int")
+	var v json.Number
+	if err := json.Unmarshal(b, &v); err != nil {
+		return utils.NewSafeError(err, "failed to JSON unmarshal bytes to number")
 	}
-	return f.SetBytes([]byte(fmt.Sprintf("%d", v)))
+	return f.SetBytes([]byte(v.String()))
 }
@@ -1,7 +1,9 @@
 package field
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/moov-io/iso8583/encoding"
 	"github.com/moov-io/iso8583/padding"
@@ -188,3 +190,378 @@ func TestNumericJSONUnmarshal(t *testing.T) {
 	require.NoError(t, numeric.UnmarshalJSON(input))
 	require.Equal(t, 4000, numeric.Value())
 }
+
+func TestNumericJSONUnmarshalNumber(t *testing.T) {
+	spec := &Spec{
+		Length:      19,
+		Description: "Field",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+	}
+
+	// int and KeepDigits fields share the json.Number decoding path, so
+	// numbers held in JSON strings are accepted for both of them
+	numeric := NewNumeric(spec)
+	require.NoError(t, numeric.UnmarshalJSON([]byte(`"1234567890123456789"`)))
+	require.Equal(t, 1234567890123456789, numeric.Value())
+
+	b, err := numeric.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `1234567890123456789`, string(b))
+
+	err = NewNumeric(spec).UnmarshalJSON([]byte(`"12A"`))
+	require.EqualError(t, err, "failed to JSON unmarshal bytes to number")
+
+	err = NewNumeric(spec).UnmarshalJSON([]byte(`12.5`))
+	require.EqualError(t, err, "failed to convert into number")
+}
+
+func TestNumericDuration(t *testing.T) {
+	t.Run("seconds", func(t *testing.T) {
+		numeric := NewNumeric(&Spec{
+			Length:      6,
+			Description: "Elapsed Time",
+			Enc:         encoding.ASCII,
+			Pref:        prefix.ASCII.Fixed,
+			Pad:         padding.Left('0'),
+		})
+
+		elapsed := 2*time.Minute + 5*time.Second
+		require.NoError(t, numeric.Marshal(&elapsed))
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "000125", string(packed))
+
+		numeric = NewNumeric(numeric.Spec())
+		_, err = numeric.Unpack(packed)
+		require.NoError(t, err)
+
+		var got time.Duration
+		require.NoError(t, numeric.Unmarshal(&got))
+		require.Equal(t, elapsed, got)
+	})
+
+	t.Run("milliseconds", func(t *testing.T) {
+		numeric := NewNumeric(&Spec{
+			Length:      6,
+			Description: "Elapsed Time",
+			Enc:         encoding.ASCII,
+			Pref:        prefix.ASCII.Fixed,
+			Pad:         padding.Left('0'),
+			Numeric:     &NumericOptions{DurationUnit: time.Millisecond},
+		})
+
+		elapsed := 1500 * time.Millisecond
+		require.NoError(t, numeric.Marshal(&elapsed))
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "001500", string(packed))
+
+		numeric = NewNumeric(numeric.Spec())
+		_, err = numeric.Unpack(packed)
+		require.NoError(t, err)
+
+		var got time.Duration
+		require.NoError(t, numeric.Unmarshal(&got))
+		require.Equal(t, elapsed, got)
+	})
+
+	t.Run("returns error for unsupported type", func(t *testing.T) {
+		numeric := NewNumeric(&Spec{})
+
+		var got string
+		require.EqualError(t, numeric.Unmarshal(&got), "data does not match required *Numeric type")
+		require.EqualError(t, numeric.Marshal(&got), "data does not match required *Numeric type")
+	})
+}
+
+func TestNumericLeadingSymbols(t *testing.T) {
+	spec := &Spec{
+		Length:      12,
+		Description: "Amount",
+		Enc:         encoding.Binary,
+		Pref:        prefix.ASCII.LL,
+		Numeric:     &NumericOptions{LeadingSymbols: "$€"},
+	}
+
+	t.Run("strips leading symbols", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+
+		require.NoError(t, numeric.SetBytes([]byte("$1234")))
+		require.Equal(t, 1234, numeric.Value())
+
+		require.NoError(t, numeric.SetBytes([]byte("€99")))
+		require.Equal(t, 99, numeric.Value())
+	})
+
+	t.Run("symbols are not packed", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+
+		_, err := numeric.Unpack([]byte("05$1234"))
+		require.NoError(t, err)
+		require.Equal(t, 1234, numeric.Value())
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "041234", string(packed))
+	})
+
+	t.Run("returns error for other symbols", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+
+		err := numeric.SetBytes([]byte("£1234"))
+		require.EqualError(t, err, "failed to convert into number")
+
+		err = numeric.SetBytes([]byte("$12$34"))
+		require.EqualError(t, err, "failed to convert into number")
+	})
+}
+
+func TestNumericBCDPadNibble(t *testing.T) {
+	tests := []struct {
+		name   string
+		enc    encoding.Encoder
+		packed []byte
+	}{
+		{
+			name:   "leading pad nibble",
+			enc:    encoding.BCD,
+			packed: []byte{0x01, 0x23},
+		},
+		{
+			name:   "trailing pad nibble",
+			enc:    encoding.LBCD,
+			packed: []byte{0x12, 0x30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &Spec{
+				Length:      3,
+				Description: "Currency Code",
+				Enc:         tt.enc,
+				Pref:        prefix.BCD.Fixed,
+			}
+
+			numeric := NewNumericValue(123)
+			numeric.SetSpec(spec)
+
+			packed, err := numeric.Pack()
+			require.NoError(t, err)
+			require.Equal(t, tt.packed, packed)
+
+			numeric = NewNumeric(spec)
+			read, err := numeric.Unpack(packed)
+			require.NoError(t, err)
+			require.Equal(t, 2, read)
+			require.Equal(t, 123, numeric.Value())
+		})
+	}
+}
+
+func TestNumericVariableLength(t *testing.T) {
+	spec := &Spec{
+		Length:      12,
+		Description: "Amount",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.LL,
+	}
+
+	tests := []struct {
+		name   string
+		value  int
+		packed string
+	}{
+		{"zero", 0, "010"},
+		{"single digit", 7, "017"},
+		{"multiple digits", 12345, "0512345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			numeric := NewNumericValue(tt.value)
+			numeric.SetSpec(spec)
+
+			packed, err := numeric.Pack()
+			require.NoError(t, err)
+			require.Equal(t, tt.packed, string(packed))
+
+			numeric = NewNumeric(spec)
+			read, err := numeric.Unpack(packed)
+			require.NoError(t, err)
+			require.Equal(t, len(tt.packed), read)
+			require.Equal(t, tt.value, numeric.Value())
+		})
+	}
+
+	t.Run("leading zeros are decoded as right-aligned value", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+
+		read, err := numeric.Unpack([]byte("03007"))
+		require.NoError(t, err)
+		require.Equal(t, 5, read)
+		require.Equal(t, 7, numeric.Value())
+	})
+
+	t.Run("empty content is decoded as zero", func(t *testing.T) {
+		numeric := NewNumericValue(42)
+		numeric.SetSpec(spec)
+
+		read, err := numeric.Unpack([]byte("00"))
+		require.NoError(t, err)
+		require.Equal(t, 2, read)
+		require.Equal(t, 0, numeric.Value())
+	})
+}
+
+func TestNumericSpaceForZero(t *testing.T) {
+	spec := &Spec{
+		Length:      2,
+		Description: "Card Sequence Number",
+		Enc:         encoding.NewSpaceForZero(encoding.ASCII, true),
+		Pref:        prefix.ASCII.Fixed,
+		Pad:         padding.Left('0'),
+	}
+
+	numeric := NewNumeric(spec)
+	read, err := numeric.Unpack([]byte(" 5"))
+	require.NoError(t, err)
+	require.Equal(t, 2, read)
+	require.Equal(t, 5, numeric.Value())
+
+	packed, err := numeric.Pack()
+	require.NoError(t, err)
+	require.Equal(t, " 5", string(packed))
+}
+
+func TestNumericKeepDigits(t *testing.T) {
+	spec := &Spec{
+		Length:      28,
+		Description: "Account Identification",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.LL,
+		Numeric:     &NumericOptions{KeepDigits: true},
+	}
+
+	digits := "1234567890123456789012345"
+
+	numeric := NewNumeric(spec)
+	read, err := numeric.Unpack([]byte("25" + digits))
+	require.NoError(t, err)
+	require.Equal(t, 27, read)
+	require.Equal(t, digits, numeric.Digits())
+	// the digits don't fit into int
+	require.Equal(t, 0, numeric.Value())
+
+	packed, err := numeric.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "25"+digits, string(packed))
+
+	b, err := json.Marshal(numeric)
+	require.NoError(t, err)
+	require.Equal(t, digits, string(b))
+
+	numeric = NewNumeric(spec)
+	require.NoError(t, json.Unmarshal(b, numeric))
+	require.Equal(t, digits, numeric.Digits())
+
+	_, err = NewNumeric(spec).Unpack([]byte("0512A45"))
+	require.EqualError(t, err, "failed to set bytes: invalid digit 'A' at position 2")
+
+	t.Run("small values are available as int", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+		require.NoError(t, numeric.SetBytes([]byte("00042")))
+		require.Equal(t, 42, numeric.Value())
+		require.Equal(t, "00042", numeric.Digits())
+
+		numeric.SetValue(7)
+		require.Equal(t, "7", numeric.Digits())
+	})
+
+	t.Run("unpacking empty value drops previous digits", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+		_, err := numeric.Unpack([]byte("0512345"))
+		require.NoError(t, err)
+
+		_, err = numeric.Unpack([]byte("00"))
+		require.NoError(t, err)
+		require.Equal(t, 0, numeric.Value())
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "010", string(packed))
+	})
+
+	t.Run("SetBytes drops digits set by SetDigits", func(t *testing.T) {
+		numeric := NewNumeric(&Spec{
+			Length: 28,
+			Enc:    encoding.ASCII,
+			Pref:   prefix.ASCII.LL,
+		})
+		require.NoError(t, numeric.SetDigits("999"))
+		require.NoError(t, numeric.SetBytes([]byte("7")))
+		require.Equal(t, 7, numeric.Value())
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "017", string(packed))
+	})
+
+	t.Run("SetData drops previous digits", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+		require.NoError(t, numeric.SetDigits("999"))
+		require.NoError(t, numeric.SetData(NewNumericValue(5)))
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "015", string(packed))
+	})
+}
+
+func TestNumericLuhn(t *testing.T) {
+	spec := &Spec{
+		Length:      19,
+		Description: "Primary Account Number",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.LL,
+		Numeric:     &NumericOptions{KeepDigits: true, Luhn: true},
+	}
+
+	t.Run("valid digits pack and unpack as plain numeric", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+		require.NoError(t, numeric.SetDigits("4111111111111111"))
+
+		packed, err := numeric.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "164111111111111111", string(packed))
+
+		numeric = NewNumeric(spec)
+		read, err := numeric.Unpack(packed)
+		require.NoError(t, err)
+		require.Equal(t, len(packed), read)
+		require.Equal(t, "4111111111111111", numeric.Digits())
+	})
+
+	t.Run("Unpack returns ErrLuhnCheck for invalid digits", func(t *testing.T) {
+		_, err := NewNumeric(spec).Unpack([]byte("164111111111111112"))
+		require.ErrorIs(t, err, ErrLuhnCheck)
+	})
+
+	t.Run("Marshal returns ErrLuhnCheck for invalid digits", func(t *testing.T) {
+		err := NewNumeric(spec).Marshal(NewNumericValue(4111111111111112))
+		require.ErrorIs(t, err, ErrLuhnCheck)
+
+		require.NoError(t, NewNumeric(spec).Marshal(NewNumericValue(4111111111111111)))
+	})
+
+	t.Run("Pack returns ErrLuhnCheck for invalid digits", func(t *testing.T) {
+		numeric := NewNumeric(spec)
+		require.NoError(t, numeric.SetDigits("4111111111111112"))
+
+		_, err := numeric.Pack()
+		require.ErrorIs(t, err, ErrLuhnCheck)
+	})
+}
@@ -1,6 +1,7 @@
 package field
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/moov-io/iso8583/encoding"
@@ -78,6 +79,39 @@ type Spec struct {
 	// Bitmap defines a bitmap field that is used only by a composite field type.
 	// It defines the way that the composite will determine its subflieds existence.
 	Bitmap *Bitmap
+	// LenientDecode marks a subfield as optional for its parent composite.
+	// When such a subfield fails to decode (e.g. it holds junk data), the
+	// composite skips over it, records the error and continues unpacking the
+	// remaining subfields instead of failing. The subfield must have an
+	// encoder so the number of bytes it occupies can be determined.
+	// By default, this flag is disabled and decode errors abort the unpacking.
+	LenientDecode bool
+	// Validator is an optional business validation (e.g. a range check)
+	// of the field content. It's called with the unpadded content after it
+	// is decoded on Unpack and before it is encoded on Pack. When it
+	// returns an error, packing or unpacking fails. Only applicable to
+	// string, numeric, binary and hex field types.
+	Validator func([]byte) error
+
+	// Options specific to a single field type. They are ignored by other
+	// field types.
+
+	// Numeric holds options of numeric field types.
+	Numeric *NumericOptions
+	// Binary holds options of binary field types.
+	Binary *BinaryOptions
+	// Composite holds options of composite field types.
+	Composite *CompositeOptions
+	// Amount holds options of amount field types.
+	Amount *AmountOptions
+	// DateTime holds options of date time field types.
+	DateTime *DateTimeOptions
+	// Flag holds options of flag field types.
+	Flag *FlagOptions
+	// Code holds options of code field types.
+	Code *CodeOptions
+	// CountedRecords holds options of counted records field types.
+	CountedRecords *CountedRecordsOptions
 }
 
 func NewSpec(length int, desc string, enc encoding.Encoder, pref prefix.Prefixer) *Spec {
@@ -89,6 +123,34 @@ func NewSpec(length int, desc string, enc encoding.Encoder, pref prefix.Prefixer
 	}
 }
 
+// validate runs the spec validator, if any, against the field content.
+func validate(spec *Spec, data []byte) error {
+	if spec.Validator == nil {
+		return nil
+	}
+
+	if err := spec.Validator(data); err != nil {
+		return fmt.Errorf("field validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// checkPad returns an error if the spec padder can't pad data to the spec
+// length.
+func checkPad(spec *Spec, data []byte) error {
+	checker, ok := spec.Pad.(padding.PadChecker)
+	if !ok {
+		return nil
+	}
+
+	if err := checker.CheckPad(data, spec.Length); err != nil {
+		return fmt.Errorf("failed to pad content: %w", err)
+	}
+
+	return nil
+}
+
 // CreateSubfield creates a new instance of a field based on the input
 // provided.
 func CreateSubfield(specField Field) Field {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/moov-io/iso8583/encoding"
 	"github.com/moov-io/iso8583/utils"
 )
 
@@ -74,7 +75,14 @@ func (f *String) SetValue(v string) {
 func (f *String) Pack() ([]byte, error) {
 	data := []byte(f.value)
 
+	if err := validate(f.spec, data); err != nil {
+		return nil, err
+	}
+
 	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
 		data = f.spec.Pad.Pad(data, f.spec.Length)
 	}
 
@@ -83,7 +91,12 @@ func (f *String) Pack() ([]byte, error) {
 		return nil, fmt.Errorf("failed to encode content: %w", err)
 	}
 
-	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, len(data))
+	length := len(data)
+	if lc, ok := f.spec.Enc.(encoding.LengthCounter); ok {
+		length = lc.Length(data)
+	}
+
+	packedLength, err := f.spec.Pref.EncodeLength(f.spec.Length, length)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode length: %w", err)
 	}
@@ -106,6 +119,10 @@ func (f *String) Unpack(data []byte) (int, error) {
 		raw = f.spec.Pad.Unpad(raw)
 	}
 
+	if err := validate(f.spec, raw); err != nil {
+		return 0, err
+	}
+
 	if err := f.SetBytes(raw); err != nil {
 		return 0, fmt.Errorf("failed to set bytes: %w", err)
 	}
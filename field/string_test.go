@@ -1,6 +1,7 @@
 package field
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/moov-io/iso8583/encoding"
@@ -124,3 +125,156 @@ func TestStringJSONMarshal(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, `"1000"`, string(marshalledJSON))
 }
+
+func TestStringWithRuneText(t *testing.T) {
+	spec := &Spec{
+		Length:      10,
+		Description: "Display Name",
+		Enc:         encoding.NewRuneText(),
+		Pref:        prefix.ASCII.LL,
+	}
+
+	str := NewStringValue("山田太郎")
+	str.SetSpec(spec)
+
+	packed, err := str.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "04山田太郎", string(packed))
+
+	str = NewString(spec)
+	read, err := str.Unpack(append(packed, "Tokyo"...))
+	require.NoError(t, err)
+	require.Equal(t, 14, read)
+	require.Equal(t, "山田太郎", str.Value())
+}
+
+func TestStringWithUTF16(t *testing.T) {
+	spec := &Spec{
+		Length:      20,
+		Description: "Cardholder Name",
+		Enc:         encoding.UTF16BE,
+		Pref:        prefix.ASCII.LL,
+	}
+
+	str := NewStringValue("AB")
+	str.SetSpec(spec)
+
+	packed, err := str.Pack()
+	require.NoError(t, err)
+	require.Equal(t, []byte{'0', '4', 0x00, 'A', 0x00, 'B'}, packed)
+
+	str = NewString(spec)
+	read, err := str.Unpack(packed)
+	require.NoError(t, err)
+	require.Equal(t, 6, read)
+	require.Equal(t, "AB", str.Value())
+}
+
+func TestStringWithEBCDICText(t *testing.T) {
+	spec := &Spec{
+		Length:      20,
+		Description: "Merchant Name",
+		Enc:         encoding.EBCDICText,
+		Pref:        prefix.EBCDIC.LL,
+	}
+
+	str := NewStringValue("café")
+	str.SetSpec(spec)
+
+	packed, err := str.Pack()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xF0, 0xF4, 0x83, 0x81, 0x86, 0x51}, packed)
+
+	str = NewString(spec)
+	read, err := str.Unpack(packed)
+	require.NoError(t, err)
+	require.Equal(t, 6, read)
+	require.Equal(t, "café", str.Value())
+}
+
+func TestStringWithMultiBytePadding(t *testing.T) {
+	spec := &Spec{
+		Length:      6,
+		Description: "Field",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+		Pad:         padding.RightBytes([]byte("-=")),
+	}
+
+	str := NewStringValue("AB")
+	str.SetSpec(spec)
+
+	packed, err := str.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "AB-=-=", string(packed))
+
+	str = NewString(spec)
+	_, err = str.Unpack(packed)
+	require.NoError(t, err)
+	require.Equal(t, "AB", str.Value())
+
+	// the missing width of 3 is not a multiple of the pad sequence
+	str = NewStringValue("ABC")
+	str.SetSpec(spec)
+
+	_, err = str.Pack()
+	require.EqualError(t, err, "failed to pad content: missing width 3 is not a multiple of pad sequence length 2")
+
+	// misaligned width is rejected for variable length fields as well
+	str = NewStringValue("ABC")
+	str.SetSpec(&Spec{
+		Length:      6,
+		Description: "Field",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.LL,
+		Pad:         padding.RightBytes([]byte("-=")),
+	})
+
+	_, err = str.Pack()
+	require.EqualError(t, err, "failed to pad content: missing width 3 is not a multiple of pad sequence length 2")
+}
+
+func TestStringFieldValidator(t *testing.T) {
+	errNotUpper := errors.New("value is not upper case")
+	spec := &Spec{
+		Length:      6,
+		Description: "Field",
+		Enc:         encoding.ASCII,
+		Pref:        prefix.ASCII.Fixed,
+		Pad:         padding.Left(' '),
+		Validator: func(data []byte) error {
+			for _, c := range data {
+				if c < 'A' || c > 'Z' {
+					return errNotUpper
+				}
+			}
+			return nil
+		},
+	}
+
+	t.Run("Pack validates content before padding", func(t *testing.T) {
+		str := NewString(spec)
+		str.SetValue("ABC")
+
+		packed, err := str.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "   ABC", string(packed))
+
+		str.SetValue("abc")
+		_, err = str.Pack()
+		require.EqualError(t, err, "field validation failed: value is not upper case")
+		require.ErrorIs(t, err, errNotUpper)
+	})
+
+	t.Run("Unpack validates unpadded content", func(t *testing.T) {
+		str := NewString(spec)
+
+		_, err := str.Unpack([]byte("   ABC"))
+		require.NoError(t, err)
+		require.Equal(t, "ABC", str.Value())
+
+		_, err = str.Unpack([]byte("   abc"))
+		require.EqualError(t, err, "field validation failed: value is not upper case")
+		require.ErrorIs(t, err, errNotUpper)
+	})
+}
@@ -69,6 +69,9 @@ func (f *Track1) Pack() ([]byte, error) {
 	}
 
 	if f.spec.Pad != nil {
+		if err := checkPad(f.spec, data); err != nil {
+			return nil, err
+		}
 		data = f.spec.Pad.Pad(data, f.spec.Length)
 	}
 
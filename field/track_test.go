@@ -1,12 +1,14 @@
 package field
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/moov-io/iso8583/encoding"
 	"github.com/moov-io/iso8583/prefix"
+	"github.com/moov-io/iso8583/sort"
 	"github.com/stretchr/testify/require"
 )
 
@@ -458,6 +460,70 @@ func TestTrack2TypedAPI(t *testing.T) {
 	})
 }
 
+func TestTrack2AsCompositeSubfield(t *testing.T) {
+	type cardData struct {
+		F1 *String
+		F2 *Track2
+	}
+
+	spec := &Spec{
+		Length:      99,
+		Description: "Card Data",
+		Pref:        prefix.ASCII.LL,
+		Tag: &TagSpec{
+			Sort: sort.StringsByInt,
+		},
+		Subfields: map[string]Field{
+			"1": NewString(&Spec{
+				Length:      2,
+				Description: "Entry Mode",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			"2": NewTrack2(&Spec{
+				Length:      37,
+				Description: "Track 2 Data",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+		},
+	}
+
+	packed := []byte("4005364000340000000506=2512111123400001230")
+
+	composite := NewComposite(spec)
+	_, err := composite.Unpack(packed)
+	require.NoError(t, err)
+
+	data := &cardData{}
+	require.NoError(t, composite.Unmarshal(data))
+	require.Equal(t, "05", data.F1.Value())
+	require.Equal(t, "4000340000000506", data.F2.PrimaryAccountNumber)
+	require.Equal(t, "2512", data.F2.ExpirationDate.Format(expiryDateFormat))
+	require.Equal(t, "111", data.F2.ServiceCode)
+	require.Equal(t, "123400001230", data.F2.DiscretionaryData)
+
+	b, err := json.Marshal(composite)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"1": "05",
+		"2": {
+			"primary_account_number": "4000340000000506",
+			"separator": "=",
+			"expiration_date": "2025-12-01T00:00:00Z",
+			"service_code": "111",
+			"discretionary_data": "123400001230"
+		}
+	}`, string(b))
+
+	composite = NewComposite(spec)
+	require.NoError(t, composite.Marshal(data))
+
+	repacked, err := composite.Pack()
+	require.NoError(t, err)
+	require.Equal(t, packed, repacked)
+}
+
 func TestTrack3TypedAPI(t *testing.T) {
 	t.Run("Track 3 untyped", func(t *testing.T) {
 		samples := []TestSample{
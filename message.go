@@ -1,6 +1,7 @@
 package iso8583
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,6 +31,32 @@ type Message struct {
 
 	// tracks which fields were set
 	fieldsMap map[int]struct{}
+
+	// holds the fields preceding the MTI, if defined by the spec
+	envelope field.Field
+
+	// when not nil, Unpack records the position of each field in the input
+	unpackOffsets map[int]FieldOffset
+
+	// holds the records following the bitmap fields, if defined by the spec
+	tailRecords []field.Field
+
+	// holds data derived from the fields, e.g. by PostUnpack callbacks
+	annotations map[string]interface{}
+
+	// when not zero, the number of fields the packed bitmap covers
+	bitmapFields int
+
+	// when not nil, holds the packed fields as they were after the last
+	// Unpack to find the fields modified since then
+	unpackedFields map[int][]byte
+}
+
+// FieldOffset describes the position of a field (including its length
+// prefix) in the data passed to Unpack.
+type FieldOffset struct {
+	Offset int
+	Length int
 }
 
 func NewMessage(spec *MessageSpec) *Message {
@@ -39,6 +66,7 @@ func NewMessage(spec *MessageSpec) *Message {
 		fields:    fields,
 		spec:      spec,
 		fieldsMap: map[int]struct{}{},
+		envelope:  spec.CreateEnvelope(),
 	}
 }
 
@@ -67,6 +95,121 @@ func (m *Message) Bitmap() *field.Bitmap {
 	return m.bitmap
 }
 
+// TrackUnpackOffsets enables recording of the position of each unpacked
+// field (MTI and bitmap included) in the input. Offsets recorded during the
+// last Unpack are returned by UnpackOffsets.
+func (m *Message) TrackUnpackOffsets() {
+	m.unpackOffsets = map[int]FieldOffset{}
+}
+
+// UnpackOffsets returns the positions of the fields recorded during the last
+// Unpack. It returns nil if tracking was not enabled with TrackUnpackOffsets.
+func (m *Message) UnpackOffsets() map[int]FieldOffset {
+	return m.unpackOffsets
+}
+
+// TrackChanges enables recording of the field values after each Unpack, so
+// the fields set or modified since then are returned by ModifiedFields.
+func (m *Message) TrackChanges() {
+	m.unpackedFields = map[int][]byte{}
+}
+
+// ModifiedFields returns the sorted IDs of the fields (MTI included) that
+// were set, modified or unset after the last Unpack. Fields are compared by
+// their packed values, so setting a field to the value it already holds is
+// not a modification. It returns nil if tracking was not enabled with
+// TrackChanges.
+func (m *Message) ModifiedFields() []int {
+	if m.unpackedFields == nil {
+		return nil
+	}
+
+	current := m.packFieldValues()
+
+	modified := []int{}
+	for id, packed := range current {
+		unpacked, found := m.unpackedFields[id]
+		if !found || packed == nil || !bytes.Equal(packed, unpacked) {
+			modified = append(modified, id)
+		}
+	}
+	for id := range m.unpackedFields {
+		if _, found := current[id]; !found {
+			modified = append(modified, id)
+		}
+	}
+
+	sort.Ints(modified)
+
+	return modified
+}
+
+// packFieldValues returns the packed values of the set fields except the
+// bitmap, which is generated on packing. Values of the fields that fail to
+// pack are nil.
+func (m *Message) packFieldValues() map[int][]byte {
+	values := map[int][]byte{}
+	for id := range m.fieldsMap {
+		if id == bitmapIdx {
+			continue
+		}
+
+		packed, err := m.fields[id].Pack()
+		if err != nil {
+			packed = nil
+		}
+		values[id] = packed
+	}
+
+	return values
+}
+
+// AddTailRecord creates a new tail record defined by the spec, marshals
+// data into it and appends it to the message.
+func (m *Message) AddTailRecord(data interface{}) error {
+	record := m.spec.CreateTailRecord()
+	if record == nil {
+		return errors.New("failed to add tail record: no specification found")
+	}
+
+	if err := record.Marshal(data); err != nil {
+		return fmt.Errorf("failed to add tail record: %w", err)
+	}
+
+	m.tailRecords = append(m.tailRecords, record)
+
+	return nil
+}
+
+// TailRecords returns the records that follow the bitmap fields in the order
+// they appear in the message.
+func (m *Message) TailRecords() []field.Field {
+	return m.tailRecords
+}
+
+// Annotate stores data derived from the message fields (e.g. a label for
+// the processing code) under the key. Annotations are not packed and are
+// reset by Unpack.
+func (m *Message) Annotate(key string, value interface{}) {
+	if m.annotations == nil {
+		m.annotations = map[string]interface{}{}
+	}
+
+	m.annotations[key] = value
+}
+
+// Annotation returns the data stored under the key with Annotate.
+func (m *Message) Annotation(key string) (interface{}, bool) {
+	value, ok := m.annotations[key]
+	return value, ok
+}
+
+// Envelope returns the field that precedes the MTI on the wire. It returns
+// nil if the spec defines no envelope.
+func (m *Message) Envelope() field.Field {
+	return m.envelope
+}
+
 func (m *Message) MTI(val string) {
 	m.fieldsMap[mtiIdx] = struct{}{}
 	m.fields[mtiIdx].SetBytes([]byte(val))
@@ -127,12 +270,34 @@ func (m *Message) GetFields() map[int]field.Field {
 }
 
 func (m *Message) Pack() ([]byte, error) {
+	packed, _, err := m.pack()
+	return packed, err
+}
+
+// pack packs the message and returns the offsets at which the bitmap fields
+// start in the packed data.
+func (m *Message) pack() ([]byte, map[int]int, error) {
 	packed := []byte{}
 	m.Bitmap().Reset()
 
+	if m.envelope != nil {
+		packedEnvelope, err := m.envelope.Pack()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to pack envelope: %w", err)
+		}
+		packed = append(packed, packedEnvelope...)
+	}
+
 	ids, err := m.packableFieldIDs()
 	if err != nil {
-		return nil, fmt.Errorf("failed to pack message: %w", err)
+		return nil, nil, fmt.Errorf("failed to pack message: %w", err)
+	}
+
+	if m.bitmapFields > 0 {
+		if err := m.checkBitmapFields(m.bitmapFields); err != nil {
+			return nil, nil, fmt.Errorf("failed to pack message: %w", err)
+		}
+		m.Bitmap().Expand(m.bitmapFields)
 	}
 
 	for _, id := range ids {
@@ -145,68 +310,373 @@ func (m *Message) Pack() ([]byte, error) {
 	}
 
 	// pack fields
+	offsets := make(map[int]int, len(ids))
 	for _, i := range ids {
 		field, ok := m.fields[i]
 		if !ok {
-			return nil, fmt.Errorf("failed to pack field %d: no specification found", i)
+			return nil, nil, fmt.Errorf("failed to pack field %d: no specification found", i)
 		}
 		packedField, err := field.Pack()
 		if err != nil {
-			return nil, fmt.Errorf("failed to pack field %d (%s): %w", i, field.Spec().Description, err)
+			return nil, nil, fmt.Errorf("failed to pack field %d (%s): %w", i, field.Spec().Description, err)
 		}
+		offsets[i] = len(packed)
 		packed = append(packed, packedField...)
 	}
 
-	return packed, nil
+	for i, record := range m.tailRecords {
+		packedRecord, err := record.Pack()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to pack tail record %d: %w", i, err)
+		}
+		packed = append(packed, packedRecord...)
+	}
+
+	return packed, offsets, nil
 }
 
-func (m *Message) Unpack(src []byte) error {
-	var off int
+// SetBitmapFields sets the number of fields (e.g. 64, 128 or 192) the
+// bitmap covers when the message is packed. Extension bitmaps are emitted up
+// to max even if no field in them is set, and are dropped beyond it. It
+// returns an error if max is not a multiple of the fields covered by a single
+// bitmap or if a set field falls outside of the new range. Zero restores the
+// default behavior of covering only the set fields.
+func (m *Message) SetBitmapFields(max int) error {
+	if max == 0 {
+		m.bitmapFields = 0
+		return nil
+	}
 
-	// reset fields that were set
-	m.fieldsMap = map[int]struct{}{}
+	// a single bitmap is 8 bytes (64 fields) unless the spec defines it
+	perBitmap := m.Bitmap().Spec().Length * 8
+	if perBitmap == 0 {
+		perBitmap = 64
+	}
+	if max < 0 || max%perBitmap != 0 {
+		return fmt.Errorf("bitmap fields %d is not a multiple of %d", max, perBitmap)
+	}
 
-	// This method implicitly also sets m.fieldsMap[bitmapIdx]
-	m.Bitmap().Reset()
+	if err := m.checkBitmapFields(max); err != nil {
+		return err
+	}
 
-	read, err := m.fields[mtiIdx].Unpack(src)
+	m.bitmapFields = max
+	return nil
+}
+
+// checkBitmapFields returns an error if a set field is outside of the max
+// number of fields covered by the bitmap.
+func (m *Message) checkBitmapFields(max int) error {
+	ids, err := m.packableFieldIDs()
 	if err != nil {
-		return fmt.Errorf("failed to unpack MTI: %w", err)
+		return err
 	}
 
-	m.fieldsMap[mtiIdx] = struct{}{}
+	for _, id := range ids {
+		if id > max {
+			return fmt.Errorf("field %d is set but the bitmap covers only %d fields", id, max)
+		}
+	}
 
-	off = read
+	return nil
+}
 
-	// unpack Bitmap
-	read, err = m.fields[bitmapIdx].Unpack(src[off:])
+// PackWithMAC packs the message and fills the MAC field (usually 64 or 128)
+// with the value returned by mac. The MAC field is first reserved with zero
+// bytes of its spec length so the rest of the message can be packed. Then mac
+// is called with the packed message from the MTI up to (but excluding) the
+// MAC field, and the message is packed again with the returned MAC. The MAC
+// field must be the last field of the bitmap; tail records that follow it
+// are not covered by the MAC.
+func (m *Message) PackWithMAC(id int, mac func(data []byte) ([]byte, error)) ([]byte, error) {
+	macField, ok := m.fields[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to pack MAC field %d: no specification found", id)
+	}
+
+	if err := m.BinaryField(id, make([]byte, macField.Spec().Length)); err != nil {
+		return nil, fmt.Errorf("failed to reserve MAC field %d: %w", id, err)
+	}
+
+	ids, err := m.packableFieldIDs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack message: %w", err)
+	}
+
+	if ids[len(ids)-1] != id {
+		return nil, fmt.Errorf("MAC field %d must be the last field of the message", id)
+	}
+
+	packed, offsets, err := m.pack()
 	if err != nil {
-		return fmt.Errorf("failed to unpack bitmap: %w", err)
+		return nil, err
 	}
 
-	off += read
+	// the MAC is calculated from the first field (MTI) without envelope
+	value, err := mac(packed[offsets[ids[0]]:offsets[id]])
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate MAC: %w", err)
+	}
 
+	if err := m.BinaryField(id, value); err != nil {
+		return nil, fmt.Errorf("failed to set MAC field %d: %w", id, err)
+	}
+
+	return m.Pack()
+}
+
+func (m *Message) Unpack(src []byte) error {
+	_, err := m.unpack(src)
+	return err
+}
+
+// UnpackWithLength unpacks the message like Unpack and returns an error if
+// the number of bytes consumed by the message differs from the expected
+// length (e.g. the length from the frame header). It helps to detect
+// corrupted or truncated messages that still unpack without errors.
+func (m *Message) UnpackWithLength(src []byte, expected int) error {
+	read, err := m.unpack(src)
+	if err != nil {
+		return err
+	}
+
+	if read != expected {
+		return fmt.Errorf("unpacked message length %d does not match expected length %d", read, expected)
+	}
+
+	return nil
+}
+
+// unpack unpacks the message and returns the number of bytes read.
+func (m *Message) unpack(src []byte) (int, error) {
+	off, err := m.unpackHeader(src)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := []int{}
 	for i := 2; i <= m.Bitmap().Len(); i++ {
 		if m.Bitmap().IsSet(i) {
-			fl, ok := m.fields[i]
-			if !ok {
-				return fmt.Errorf("failed to unpack field %d: no specification found", i)
-			}
+			ids = append(ids, i)
+		}
+	}
 
-			read, err = fl.Unpack(src[off:])
-			if err != nil {
-				return fmt.Errorf("failed to unpack field %d (%s): %w", i, fl.Spec().Description, err)
-			}
+	if len(m.spec.PackOrder) > 0 {
+		ids, err = m.orderFieldIDs(ids)
+		if err != nil {
+			return 0, fmt.Errorf("failed to unpack message: %w", err)
+		}
+	}
 
-			m.fieldsMap[i] = struct{}{}
+	for _, i := range ids {
+		fl, ok := m.fields[i]
+		if !ok {
+			return 0, fmt.Errorf("failed to unpack field %d: no specification found", i)
+		}
+
+		read, err := fl.Unpack(src[off:])
+		if err != nil {
+			return 0, fmt.Errorf("failed to unpack field %d (%s): %w", i, fl.Spec().Description, err)
+		}
+
+		m.fieldsMap[i] = struct{}{}
+		m.recordUnpackOffset(i, off, read)
+
+		off += read
+	}
+
+	if err := m.validatePairedLengths(); err != nil {
+		return 0, fmt.Errorf("failed to unpack message: %w", err)
+	}
+
+	if m.spec.TailRecord != nil {
+		// tail records consume all remaining data
+		if err := m.unpackTailRecords(src[off:]); err != nil {
+			return 0, err
+		}
+		off = len(src)
+	}
+
+	if err := m.runPostUnpack(); err != nil {
+		return 0, fmt.Errorf("failed to unpack message: %w", err)
+	}
+
+	if m.unpackedFields != nil {
+		m.unpackedFields = m.packFieldValues()
+	}
+
+	return off, nil
+}
+
+// runPostUnpack calls the spec PostUnpack callbacks for the fields that are
+// present in the message.
+func (m *Message) runPostUnpack() error {
+	ids := make([]int, 0, len(m.spec.PostUnpack))
+	for id := range m.spec.PostUnpack {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		if _, set := m.fieldsMap[id]; !set {
+			continue
+		}
+
+		if err := m.spec.PostUnpack[id](m, m.fields[id]); err != nil {
+			return fmt.Errorf("failed to run post-unpack callback for field %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
 
-			off += read
+// unpackTailRecords unpacks the records defined by the spec TailRecord
+// until the end of src.
+func (m *Message) unpackTailRecords(src []byte) error {
+	off := 0
+	for off < len(src) {
+		record := m.spec.CreateTailRecord()
+
+		read, err := record.Unpack(src[off:])
+		if err != nil {
+			return fmt.Errorf("failed to unpack tail record %d: %w", len(m.tailRecords), err)
 		}
+
+		if read == 0 {
+			return fmt.Errorf("failed to unpack tail record %d: no data was read", len(m.tailRecords))
+		}
+
+		m.tailRecords = append(m.tailRecords, record)
+
+		off += read
 	}
 
 	return nil
 }
 
+func (m *Message) recordUnpackOffset(id, offset, length int) {
+	if m.unpackOffsets == nil {
+		return
+	}
+
+	m.unpackOffsets[id] = FieldOffset{Offset: offset, Length: length}
+}
+
+// validatePairedLengths checks that length of each data field defined in
+// the spec PairedLengths matches the value of its length field.
+func (m *Message) validatePairedLengths() error {
+	dataIDs := make([]int, 0, len(m.spec.PairedLengths))
+	for id := range m.spec.PairedLengths {
+		dataIDs = append(dataIDs, id)
+	}
+	sort.Ints(dataIDs)
+
+	for _, dataID := range dataIDs {
+		lengthID := m.spec.PairedLengths[dataID]
+
+		_, dataSet := m.fieldsMap[dataID]
+		_, lengthSet := m.fieldsMap[lengthID]
+		if !dataSet || !lengthSet {
+			continue
+		}
+
+		data, err := m.fields[dataID].Bytes()
+		if err != nil {
+			return fmt.Errorf("failed to get bytes of field %d: %w", dataID, err)
+		}
+
+		lengthStr, err := m.fields[lengthID].String()
+		if err != nil {
+			return fmt.Errorf("failed to get value of length field %d: %w", lengthID, err)
+		}
+
+		length, err := strconv.Atoi(lengthStr)
+		if err != nil {
+			return fmt.Errorf("failed to convert value of length field %d into number: %w", lengthID, err)
+		}
+
+		if length != len(data) {
+			return fmt.Errorf("length of field %d is %d, but length field %d holds %d", dataID, len(data), lengthID, length)
+		}
+	}
+
+	return nil
+}
+
+// UnpackHeader unpacks only the MTI and the bitmap of the message and
+// returns the MTI along with the numbers of the fields present in the
+// message. Field values are not decoded, which makes it suitable for
+// routing messages. After the call, the message holds only the MTI and the
+// bitmap.
+func (m *Message) UnpackHeader(src []byte) (string, []int, error) {
+	if _, err := m.unpackHeader(src); err != nil {
+		return "", nil, err
+	}
+
+	mti, err := m.GetMTI()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get MTI: %w", err)
+	}
+
+	var fields []int
+	for i := 2; i <= m.Bitmap().Len(); i++ {
+		if m.Bitmap().IsSet(i) {
+			fields = append(fields, i)
+		}
+	}
+
+	return mti, fields, nil
+}
+
+// unpackHeader resets the message and unpacks the envelope, MTI and bitmap.
+// It returns the offset of the first data field.
+func (m *Message) unpackHeader(src []byte) (int, error) {
+	var off int
+
+	// reset fields, tail records and annotations that were set
+	m.fieldsMap = map[int]struct{}{}
+	m.tailRecords = nil
+	m.annotations = nil
+
+	if m.unpackOffsets != nil {
+		m.unpackOffsets = map[int]FieldOffset{}
+	}
+
+	// This method implicitly also sets m.fieldsMap[bitmapIdx]
+	m.Bitmap().Reset()
+
+	if m.envelope != nil {
+		read, err := m.envelope.Unpack(src)
+		if err != nil {
+			return 0, fmt.Errorf("failed to unpack envelope: %w", err)
+		}
+
+		off = read
+	}
+
+	read, err := m.fields[mtiIdx].Unpack(src[off:])
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack MTI: %w", err)
+	}
+
+	m.fieldsMap[mtiIdx] = struct{}{}
+	m.recordUnpackOffset(mtiIdx, off, read)
+
+	off += read
+
+	// unpack Bitmap
+	read, err = m.fields[bitmapIdx].Unpack(src[off:])
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack bitmap: %w", err)
+	}
+
+	m.recordUnpackOffset(bitmapIdx, off, read)
+
+	off += read
+
+	return off, nil
+}
+
 func (m *Message) MarshalJSON() ([]byte, error) {
 	// by packing the message we will generate bitmap
 	// create HEX representation
@@ -224,6 +694,11 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		if id == bitmapIdx {
 			continue
 		}
+		// skip fields that are not packed, so JSON matches the packed
+		// message
+		if isAbsent(field) {
+			continue
+		}
 		strFieldMap[fmt.Sprint(id)] = field
 	}
 
@@ -272,12 +747,57 @@ func (m *Message) packableFieldIDs() ([]int, error) {
 			continue
 		}
 
+		if isAbsent(m.fields[id]) {
+			continue
+		}
+
 		populatedFieldIDs = append(populatedFieldIDs, id)
 	}
 
 	sort.Ints(populatedFieldIDs)
 
-	return populatedFieldIDs, nil
+	if len(m.spec.PackOrder) == 0 {
+		return populatedFieldIDs, nil
+	}
+
+	return m.orderFieldIDs(populatedFieldIDs)
+}
+
+// orderFieldIDs arranges sorted field IDs according to the spec PackOrder.
+// The MTI and bitmap are kept in front of the data fields.
+func (m *Message) orderFieldIDs(ids []int) ([]int, error) {
+	set := map[int]bool{}
+	ordered := []int{}
+	for _, id := range ids {
+		if id < 2 {
+			ordered = append(ordered, id)
+			continue
+		}
+		set[id] = true
+	}
+
+	for _, id := range m.spec.PackOrder {
+		if set[id] {
+			ordered = append(ordered, id)
+			delete(set, id)
+		}
+	}
+
+	// report the first (lowest) field missing in the pack order
+	for _, id := range ids {
+		if set[id] {
+			return nil, fmt.Errorf("field %d is not defined in the pack order", id)
+		}
+	}
+
+	return ordered, nil
+}
+
+// isAbsent returns true if the field is set, but its value should be
+// treated as absent (see field.AbsentChecker).
+func isAbsent(f field.Field) bool {
+	absent, ok := f.(field.AbsentChecker)
+	return ok && absent.IsAbsent()
 }
 
 // Clone clones the message by creating a new message from the binary
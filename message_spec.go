@@ -9,8 +9,39 @@ import (
 type MessageSpec struct {
 	Name   string
 	Fields map[int]field.Field
+	// Envelope defines an optional field (usually a composite) that precedes
+	// the MTI on the wire, e.g. a protocol header with version and routing
+	// information. It's unpacked before the MTI and packed in front of it.
+	Envelope field.Field
+	// PackOrder defines an explicit order in which data fields (2 and
+	// above) are packed, for hosts that don't expect the ascending order.
+	// The MTI and bitmap are always packed first. Every field that is set
+	// in the message must be listed, otherwise packing fails. Fields
+	// present in the bitmap are unpacked in the same order.
+	PackOrder []int
+	// PairedLengths maps a data field to the field that carries its length
+	// (e.g. {48: 47} when field 47 holds the length of field 48). After
+	// unpacking, when both fields are present, the length of the data field
+	// value must be equal to the numeric value of the length field,
+	// otherwise Unpack returns an error.
+	PairedLengths map[int]int
+	// TailRecord defines an optional field (usually a composite) for the
+	// detail records that follow the bitmap fields, e.g. in settlement
+	// messages. The records repeat until the end of the message data. Each
+	// record is a new instance of this field.
+	TailRecord field.Field
+	// PostUnpack defines callbacks that are called for the unpacked fields
+	// after the whole message is unpacked. Callbacks are called in the
+	// ascending order of field numbers, only for fields present in the
+	// message. They can be used to derive data from the field values and
+	// store it in the message with Annotate.
+	PostUnpack map[int]PostUnpackFunc
 }
 
+// PostUnpackFunc is called with the message and its unpacked field. An
+// error returned by the callback fails the unpacking.
+type PostUnpackFunc func(m *Message, f field.Field) error
+
 // Creates a map with new instances of Fields (Field interface)
 // based on the field type in MessageSpec.
 func (s *MessageSpec) CreateMessageFields() map[int]field.Field {
@@ -24,6 +55,26 @@ func (s *MessageSpec) CreateMessageFields() map[int]field.Field {
 	return fields
 }
 
+// CreateEnvelope creates a new instance of the envelope field defined in the
+// spec. It returns nil if the spec defines no envelope.
+func (s *MessageSpec) CreateEnvelope() field.Field {
+	if s.Envelope == nil {
+		return nil
+	}
+
+	return createMessageField(s.Envelope)
+}
+
+// CreateTailRecord creates a new instance of the tail record field defined
+// in the spec. It returns nil if the spec defines no tail record.
+func (s *MessageSpec) CreateTailRecord() field.Field {
+	if s.TailRecord == nil {
+		return nil
+	}
+
+	return createMessageField(s.TailRecord)
+}
+
 func createMessageField(specField field.Field) field.Field {
 	fieldType := reflect.TypeOf(specField).Elem()
 
@@ -3,6 +3,7 @@ package iso8583
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -1568,3 +1569,970 @@ func FuzzUnpack(f *testing.F) {
 		message.Unpack(orig)
 	})
 }
+
+func TestMessageEnvelope(t *testing.T) {
+	spec := &MessageSpec{
+		Envelope: field.NewComposite(&field.Spec{
+			Length:      6,
+			Description: "Protocol Header",
+			Pref:        prefix.ASCII.Fixed,
+			Tag: &field.TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]field.Field{
+				"1": field.NewString(&field.Spec{
+					Length:      2,
+					Description: "Version",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				"2": field.NewString(&field.Spec{
+					Length:      4,
+					Description: "Routing",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+			},
+		}),
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+		},
+	}
+
+	type envelopeData struct {
+		F1 *field.String
+		F2 *field.String
+	}
+
+	message := NewMessage(spec)
+	message.MTI("0100")
+	require.NoError(t, message.Field(2, "4242424242424242"))
+	require.NoError(t, message.Envelope().Marshal(&envelopeData{
+		F1: field.NewStringValue("01"),
+		F2: field.NewStringValue("RT42"),
+	}))
+
+	packed, err := message.Pack()
+	require.NoError(t, err)
+	require.Equal(t, "01RT4201004000000000000000164242424242424242", string(packed))
+
+	message = NewMessage(spec)
+	require.NoError(t, message.Unpack(packed))
+
+	mti, err := message.GetMTI()
+	require.NoError(t, err)
+	require.Equal(t, "0100", mti)
+
+	pan, err := message.GetString(2)
+	require.NoError(t, err)
+	require.Equal(t, "4242424242424242", pan)
+
+	data := &envelopeData{}
+	require.NoError(t, message.Envelope().Unmarshal(data))
+	require.Equal(t, "01", data.F1.Value())
+	require.Equal(t, "RT42", data.F2.Value())
+
+	repacked, err := message.Pack()
+	require.NoError(t, err)
+	require.Equal(t, packed, repacked)
+}
+
+func TestMessagePackWithMAC(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.Binary,
+				Pref:        prefix.Binary.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+			64: field.NewBinary(&field.Spec{
+				Length:      8,
+				Description: "Message Authentication Code (MAC)",
+				Enc:         encoding.Binary,
+				Pref:        prefix.Binary.Fixed,
+			}),
+		},
+	}
+
+	// dummy MAC: XOR of all bytes folded into 8 bytes
+	dummyMAC := func(data []byte) ([]byte, error) {
+		mac := make([]byte, 8)
+		for i, b := range data {
+			mac[i%8] ^= b
+		}
+		return mac, nil
+	}
+
+	t.Run("fills MAC field with calculated value", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.Field(2, "4242424242424242"))
+
+		packed, err := message.PackWithMAC(64, dummyMAC)
+		require.NoError(t, err)
+
+		// MAC is calculated over everything but the MAC field itself
+		wantMAC, err := dummyMAC(packed[:len(packed)-8])
+		require.NoError(t, err)
+		require.Equal(t, wantMAC, packed[len(packed)-8:])
+
+		message = NewMessage(spec)
+		require.NoError(t, message.Unpack(packed))
+
+		mac, err := message.GetBytes(64)
+		require.NoError(t, err)
+		require.Equal(t, wantMAC, mac)
+
+		pan, err := message.GetString(2)
+		require.NoError(t, err)
+		require.Equal(t, "4242424242424242", pan)
+	})
+
+	t.Run("returns error when MAC field is not the last one", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.BinaryField(64, make([]byte, 8)))
+
+		_, err := message.PackWithMAC(2, dummyMAC)
+		require.EqualError(t, err, "MAC field 2 must be the last field of the message")
+	})
+
+	t.Run("returns error when MAC calculation fails", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0100")
+
+		_, err := message.PackWithMAC(64, func(data []byte) ([]byte, error) {
+			return nil, errors.New("no key")
+		})
+		require.EqualError(t, err, "failed to calculate MAC: no key")
+	})
+
+	t.Run("MAC does not cover tail records", func(t *testing.T) {
+		specWithTail := &MessageSpec{
+			Fields: spec.Fields,
+			TailRecord: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Detail Record",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		}
+
+		message := NewMessage(specWithTail)
+		message.MTI("0100")
+		require.NoError(t, message.Field(2, "4242424242424242"))
+		require.NoError(t, message.AddTailRecord(field.NewStringValue("R001")))
+		require.NoError(t, message.AddTailRecord(field.NewStringValue("R002")))
+
+		var macInput []byte
+		packed, err := message.PackWithMAC(64, func(data []byte) ([]byte, error) {
+			macInput = data
+			return dummyMAC(data)
+		})
+		require.NoError(t, err)
+
+		// MAC field is followed by the tail records
+		require.Equal(t, "R001R002", string(packed[len(packed)-8:]))
+		macEnd := len(packed) - 8 - 8
+		require.Equal(t, packed[:macEnd], macInput)
+
+		wantMAC, err := dummyMAC(packed[:macEnd])
+		require.NoError(t, err)
+		require.Equal(t, wantMAC, packed[macEnd:macEnd+8])
+	})
+}
+
+func TestMessageUnpackFieldNotDefinedInSpec(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+		},
+	}
+
+	// bitmap has bits 2 and 3 set, but field 3 is not defined in the spec
+	message := NewMessage(spec)
+	err := message.Unpack([]byte("01006000000000000000164242424242424242123456"))
+	require.EqualError(t, err, "failed to unpack field 3: no specification found")
+}
+
+func TestMessageUnpackHeader(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+			4: field.NewNumeric(&field.Spec{
+				Length:      12,
+				Description: "Transaction Amount",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+			70: field.NewNumeric(&field.Spec{
+				Length:      3,
+				Description: "Network Management Information Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		},
+	}
+
+	message := NewMessage(spec)
+	message.MTI("0800")
+	require.NoError(t, message.Field(2, "4242424242424242"))
+	require.NoError(t, message.Field(4, "100"))
+	require.NoError(t, message.Field(70, "301"))
+
+	packed, err := message.Pack()
+	require.NoError(t, err)
+
+	t.Run("returns MTI and present fields", func(t *testing.T) {
+		message := NewMessage(spec)
+
+		mti, fields, err := message.UnpackHeader(packed)
+		require.NoError(t, err)
+		require.Equal(t, "0800", mti)
+		require.Equal(t, []int{2, 4, 70}, fields)
+
+		// fields are not decoded
+		require.Len(t, message.GetFields(), 2)
+	})
+
+	t.Run("returns error for malformed bitmap", func(t *testing.T) {
+		message := NewMessage(spec)
+
+		_, _, err := message.UnpackHeader([]byte("0800ZZ"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to unpack bitmap")
+	})
+}
+
+func TestMessageZeroIsAbsent(t *testing.T) {
+	newSpec := func(zeroIsAbsent bool) *MessageSpec {
+		return &MessageSpec{
+			Fields: map[int]field.Field{
+				0: field.NewString(&field.Spec{
+					Length:      4,
+					Description: "Message Type Indicator",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				1: field.NewBitmap(&field.Spec{
+					Description: "Bitmap",
+					Enc:         encoding.BytesToASCIIHex,
+					Pref:        prefix.Hex.Fixed,
+				}),
+				3: field.NewNumeric(&field.Spec{
+					Length:      6,
+					Description: "Processing Code",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+					Pad:         padding.Left('0'),
+				}),
+				4: field.NewNumeric(&field.Spec{
+					Length:      12,
+					Description: "Transaction Amount",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+					Pad:         padding.Left('0'),
+					Numeric:     &field.NumericOptions{ZeroIsAbsent: zeroIsAbsent},
+				}),
+				102: field.NewNumeric(&field.Spec{
+					Length:      28,
+					Description: "Account Identification 1",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.LL,
+					Numeric:     &field.NumericOptions{KeepDigits: true, ZeroIsAbsent: zeroIsAbsent},
+				}),
+			},
+		}
+	}
+
+	t.Run("zero is packed as a value by default", func(t *testing.T) {
+		message := NewMessage(newSpec(false))
+		message.MTI("0100")
+		require.NoError(t, message.Field(3, "0"))
+		require.NoError(t, message.Field(4, "0"))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "01003000000000000000000000000000000000", string(packed))
+	})
+
+	t.Run("zero is not packed when it means absent", func(t *testing.T) {
+		message := NewMessage(newSpec(true))
+		message.MTI("0100")
+		require.NoError(t, message.Field(3, "0"))
+		require.NoError(t, message.Field(4, "0"))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "01002000000000000000000000", string(packed))
+
+		require.NoError(t, message.Field(4, "100"))
+
+		packed, err = message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "01003000000000000000000000000000000100", string(packed))
+	})
+
+	t.Run("absent zero is not included in JSON", func(t *testing.T) {
+		message := NewMessage(newSpec(true))
+		message.MTI("0100")
+		require.NoError(t, message.Field(3, "0"))
+		require.NoError(t, message.Field(4, "0"))
+
+		b, err := json.Marshal(message)
+		require.NoError(t, err)
+		require.Equal(t, `{"0":"0100","3":0}`, string(b))
+	})
+
+	t.Run("kept digits that don't fit into int are not absent", func(t *testing.T) {
+		message := NewMessage(newSpec(true))
+		message.MTI("0100")
+		require.NoError(t, message.Field(102, "1234567890123456789012345"))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "0100"+"80000000000000000000000004000000"+"251234567890123456789012345", string(packed))
+
+		require.NoError(t, message.Field(102, "0000"))
+
+		packed, err = message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "01000000000000000000", string(packed))
+	})
+}
+
+func TestMessagePackOrder(t *testing.T) {
+	spec := &MessageSpec{
+		PackOrder: []int{4, 2, 3},
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+			3: field.NewNumeric(&field.Spec{
+				Length:      6,
+				Description: "Processing Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+			4: field.NewNumeric(&field.Spec{
+				Length:      12,
+				Description: "Transaction Amount",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+			5: field.NewNumeric(&field.Spec{
+				Length:      12,
+				Description: "Settlement Amount",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+		},
+	}
+
+	t.Run("fields are packed in the pack order", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.Field(2, "4242424242424242"))
+		require.NoError(t, message.Field(3, "123456"))
+		require.NoError(t, message.Field(4, "100"))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "01007000000000000000000000000100164242424242424242123456", string(packed))
+
+		message = NewMessage(spec)
+		require.NoError(t, message.Unpack(packed))
+
+		pan, err := message.GetString(2)
+		require.NoError(t, err)
+		require.Equal(t, "4242424242424242", pan)
+
+		code, err := message.GetString(3)
+		require.NoError(t, err)
+		require.Equal(t, "123456", code)
+
+		amount, err := message.GetString(4)
+		require.NoError(t, err)
+		require.Equal(t, "100", amount)
+	})
+
+	t.Run("returns error when set field is not in the pack order", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.Field(2, "4242424242424242"))
+		require.NoError(t, message.Field(5, "100"))
+
+		_, err := message.Pack()
+		require.EqualError(t, err, "failed to pack message: field 5 is not defined in the pack order")
+	})
+}
+
+func TestMessagePairedLengths(t *testing.T) {
+	spec := &MessageSpec{
+		PairedLengths: map[int]int{48: 47},
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			47: field.NewNumeric(&field.Spec{
+				Length:      3,
+				Description: "Additional Data Length",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+			48: field.NewString(&field.Spec{
+				Length:      999,
+				Description: "Additional Data",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LLL,
+			}),
+		},
+	}
+
+	pack := func(t *testing.T, length, data string) []byte {
+		t.Helper()
+
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.Field(47, length))
+		require.NoError(t, message.Field(48, data))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+
+		return packed
+	}
+
+	t.Run("Unpack succeeds when lengths match", func(t *testing.T) {
+		message := NewMessage(spec)
+		require.NoError(t, message.Unpack(pack(t, "5", "HELLO")))
+	})
+
+	t.Run("Unpack returns error when lengths don't match", func(t *testing.T) {
+		message := NewMessage(spec)
+		err := message.Unpack(pack(t, "7", "HELLO"))
+		require.EqualError(t, err, "failed to unpack message: length of field 48 is 5, but length field 47 holds 7")
+	})
+}
+
+func TestMessageUnpackOffsets(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+			4: field.NewNumeric(&field.Spec{
+				Length:      12,
+				Description: "Transaction Amount",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+		},
+	}
+
+	message := NewMessage(spec)
+	message.MTI("0100")
+	require.NoError(t, message.Field(2, "4242424242424242"))
+	require.NoError(t, message.Field(4, "100"))
+
+	packed, err := message.Pack()
+	require.NoError(t, err)
+
+	t.Run("offsets are not recorded by default", func(t *testing.T) {
+		message := NewMessage(spec)
+		require.NoError(t, message.Unpack(packed))
+		require.Nil(t, message.UnpackOffsets())
+	})
+
+	t.Run("recorded offsets point to raw field data", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.TrackUnpackOffsets()
+		require.NoError(t, message.Unpack(packed))
+
+		offsets := message.UnpackOffsets()
+		require.Equal(t, map[int]FieldOffset{
+			0: {Offset: 0, Length: 4},
+			1: {Offset: 4, Length: 16},
+			2: {Offset: 20, Length: 18},
+			4: {Offset: 38, Length: 12},
+		}, offsets)
+
+		raw := func(id int) string {
+			return string(packed[offsets[id].Offset : offsets[id].Offset+offsets[id].Length])
+		}
+
+		require.Equal(t, "164242424242424242", raw(2))
+		require.Equal(t, "000000000100", raw(4))
+	})
+}
+
+func TestMessageTailRecords(t *testing.T) {
+	type detailRecord struct {
+		Reference *field.String  `index:"1"`
+		Amount    *field.Numeric `index:"2"`
+	}
+
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			74: field.NewNumeric(&field.Spec{
+				Length:      10,
+				Description: "Credits, Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+				Pad:         padding.Left('0'),
+			}),
+		},
+		TailRecord: field.NewComposite(&field.Spec{
+			Length:      10,
+			Description: "Detail Record",
+			Pref:        prefix.ASCII.Fixed,
+			Tag: &field.TagSpec{
+				Sort: sort.StringsByInt,
+			},
+			Subfields: map[string]field.Field{
+				"1": field.NewString(&field.Spec{
+					Length:      4,
+					Description: "Reference",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				"2": field.NewNumeric(&field.Spec{
+					Length:      6,
+					Description: "Amount",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+					Pad:         padding.Left('0'),
+				}),
+			},
+		}),
+	}
+
+	message := NewMessage(spec)
+	message.MTI("0500")
+	require.NoError(t, message.Field(74, "3"))
+
+	details := []struct {
+		reference string
+		amount    int
+	}{
+		{"R001", 100},
+		{"R002", 250},
+		{"R003", 5},
+	}
+
+	for _, detail := range details {
+		require.NoError(t, message.AddTailRecord(&detailRecord{
+			Reference: field.NewStringValue(detail.reference),
+			Amount:    field.NewNumericValue(detail.amount),
+		}))
+	}
+
+	packed, err := message.Pack()
+	require.NoError(t, err)
+
+	want := "0500" + "80000000000000000040000000000000" + "0000000003" +
+		"R001000100" + "R002000250" + "R003000005"
+	require.Equal(t, want, string(packed))
+
+	message = NewMessage(spec)
+	require.NoError(t, message.Unpack(packed))
+
+	count, err := message.GetString(74)
+	require.NoError(t, err)
+	require.Equal(t, "3", count)
+
+	records := message.TailRecords()
+	require.Len(t, records, 3)
+
+	for i, detail := range details {
+		data := &detailRecord{}
+		require.NoError(t, records[i].Unmarshal(data))
+		require.Equal(t, detail.reference, data.Reference.Value())
+		require.Equal(t, detail.amount, data.Amount.Value())
+	}
+
+	t.Run("Unpack returns error for truncated record", func(t *testing.T) {
+		message := NewMessage(spec)
+		err := message.Unpack(packed[:len(packed)-2])
+		require.EqualError(t, err, "failed to unpack tail record 2: not enough data to unpack, expected: 10, got: 8")
+	})
+
+	t.Run("AddTailRecord returns error without spec", func(t *testing.T) {
+		message := NewMessage(&MessageSpec{Fields: spec.Fields})
+		err := message.AddTailRecord(&detailRecord{})
+		require.EqualError(t, err, "failed to add tail record: no specification found")
+	})
+}
+
+func TestMessagePostUnpack(t *testing.T) {
+	labels := map[string]string{
+		"00": "Purchase",
+		"01": "Withdrawal",
+	}
+
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			3: field.NewString(&field.Spec{
+				Length:      6,
+				Description: "Processing Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		},
+		PostUnpack: map[int]PostUnpackFunc{
+			3: func(m *Message, f field.Field) error {
+				code, err := f.String()
+				if err != nil {
+					return err
+				}
+
+				label, found := labels[code[:2]]
+				if !found {
+					return errors.New("unknown transaction type")
+				}
+
+				m.Annotate("transaction_type", label)
+
+				return nil
+			},
+		},
+	}
+
+	pack := func(t *testing.T, code string) []byte {
+		t.Helper()
+
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.Field(3, code))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+
+		return packed
+	}
+
+	t.Run("callback stores derived data in the message", func(t *testing.T) {
+		message := NewMessage(spec)
+		require.NoError(t, message.Unpack(pack(t, "010000")))
+
+		label, ok := message.Annotation("transaction_type")
+		require.True(t, ok)
+		require.Equal(t, "Withdrawal", label)
+	})
+
+	t.Run("callback error fails unpacking", func(t *testing.T) {
+		message := NewMessage(spec)
+		err := message.Unpack(pack(t, "990000"))
+		require.EqualError(t, err, "failed to unpack message: failed to run post-unpack callback for field 3: unknown transaction type")
+
+		_, ok := message.Annotation("transaction_type")
+		require.False(t, ok)
+	})
+}
+
+func TestMessageSetBitmapFields(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			3: field.NewString(&field.Spec{
+				Length:      6,
+				Description: "Processing Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			70: field.NewString(&field.Spec{
+				Length:      3,
+				Description: "Network Management Information Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		},
+	}
+
+	t.Run("drops secondary bitmap when converting from 128 to 64 fields", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0100")
+		require.NoError(t, message.Field(3, "000000"))
+		require.NoError(t, message.SetBitmapFields(128))
+
+		packed, err := message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "0100A0000000000000000000000000000000000000", string(packed))
+
+		message = NewMessage(spec)
+		require.NoError(t, message.Unpack(packed))
+		require.NoError(t, message.SetBitmapFields(64))
+
+		packed, err = message.Pack()
+		require.NoError(t, err)
+		require.Equal(t, "01002000000000000000000000", string(packed))
+	})
+
+	t.Run("returns error when set field is outside of the range", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.MTI("0800")
+		require.NoError(t, message.Field(70, "001"))
+
+		err := message.SetBitmapFields(64)
+		require.EqualError(t, err, "field 70 is set but the bitmap covers only 64 fields")
+
+		require.NoError(t, message.SetBitmapFields(128))
+		require.NoError(t, message.Field(3, "000000"))
+
+		_, err = message.Pack()
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error for invalid number of fields", func(t *testing.T) {
+		message := NewMessage(spec)
+
+		err := message.SetBitmapFields(100)
+		require.EqualError(t, err, "bitmap fields 100 is not a multiple of 64")
+	})
+}
+
+func TestMessageUnpackWithLength(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+		},
+	}
+
+	packed := []byte("0100400000000000000016" + "4242424242424242")
+
+	t.Run("consumed length matches", func(t *testing.T) {
+		message := NewMessage(spec)
+		require.NoError(t, message.UnpackWithLength(packed, len(packed)))
+
+		pan, err := message.GetString(2)
+		require.NoError(t, err)
+		require.Equal(t, "4242424242424242", pan)
+	})
+
+	t.Run("length mismatch is detected", func(t *testing.T) {
+		// frame header says there are 2 more bytes than the message holds
+		message := NewMessage(spec)
+		err := message.UnpackWithLength(append(packed, "XX"...), len(packed)+2)
+		require.EqualError(t, err, "unpacked message length 38 does not match expected length 40")
+	})
+}
+
+func TestMessageModifiedFields(t *testing.T) {
+	spec := &MessageSpec{
+		Fields: map[int]field.Field{
+			0: field.NewString(&field.Spec{
+				Length:      4,
+				Description: "Message Type Indicator",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			1: field.NewBitmap(&field.Spec{
+				Description: "Bitmap",
+				Enc:         encoding.BytesToASCIIHex,
+				Pref:        prefix.Hex.Fixed,
+			}),
+			2: field.NewString(&field.Spec{
+				Length:      19,
+				Description: "Primary Account Number",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.LL,
+			}),
+			3: field.NewString(&field.Spec{
+				Length:      6,
+				Description: "Processing Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+			39: field.NewString(&field.Spec{
+				Length:      2,
+				Description: "Response Code",
+				Enc:         encoding.ASCII,
+				Pref:        prefix.ASCII.Fixed,
+			}),
+		},
+	}
+
+	request := NewMessage(spec)
+	request.MTI("0100")
+	require.NoError(t, request.Field(2, "4242424242424242"))
+	require.NoError(t, request.Field(3, "000000"))
+	require.NoError(t, request.Field(39, "00"))
+
+	packed, err := request.Pack()
+	require.NoError(t, err)
+
+	t.Run("reports fields modified after unpack", func(t *testing.T) {
+		message := NewMessage(spec)
+		message.TrackChanges()
+		require.NoError(t, message.Unpack(packed))
+		require.Empty(t, message.ModifiedFields())
+
+		// setting the same value is not a modification
+		require.NoError(t, message.Field(3, "000000"))
+		require.NoError(t, message.Field(39, "05"))
+
+		require.Equal(t, []int{39}, message.ModifiedFields())
+
+		// unpacking again resets the tracked values
+		require.NoError(t, message.Unpack(packed))
+		require.Empty(t, message.ModifiedFields())
+	})
+
+	t.Run("returns nil when tracking is not enabled", func(t *testing.T) {
+		message := NewMessage(spec)
+		require.NoError(t, message.Unpack(packed))
+		require.NoError(t, message.Field(39, "05"))
+
+		require.Nil(t, message.ModifiedFields())
+	})
+}
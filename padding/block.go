@@ -0,0 +1,95 @@
+package padding
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// BlockPadder pads the whole packed value to a multiple of the block size.
+// Unlike Padder, it doesn't know the field length and is used for the data
+// that is going to be encrypted with a block cipher.
+type BlockPadder interface {
+	Pad(data []byte) []byte
+	Unpad(data []byte) ([]byte, error)
+}
+
+// ZeroBlock returns a new block padder which pads with zero bytes
+var ZeroBlock func(size int) BlockPadder = NewZeroBlockPadder
+
+// PKCS7Block returns a new block padder which pads according to PKCS#7
+var PKCS7Block func(size int) BlockPadder = NewPKCS7BlockPadder
+
+type zeroBlockPadder struct {
+	size int
+}
+
+// NewZeroBlockPadder returns a padder which appends zero bytes to the data
+// until its length is a multiple of size. Data which is already aligned is
+// left as is. Unpad removes all trailing zero bytes, so it should not be used
+// when the data itself may end with zero bytes. It panics if size is less
+// than 1.
+func NewZeroBlockPadder(size int) BlockPadder {
+	if size < 1 {
+		panic(fmt.Sprintf("block size %d should be at least 1", size))
+	}
+
+	return &zeroBlockPadder{size: size}
+}
+
+func (p *zeroBlockPadder) Pad(data []byte) []byte {
+	if len(data)%p.size == 0 {
+		return data
+	}
+
+	padding := make([]byte, p.size-len(data)%p.size)
+	return append(data, padding...)
+}
+
+func (p *zeroBlockPadder) Unpad(data []byte) ([]byte, error) {
+	if len(data)%p.size != 0 {
+		return nil, fmt.Errorf("data length %d is not a multiple of block size %d", len(data), p.size)
+	}
+
+	return bytes.TrimRight(data, "\x00"), nil
+}
+
+type pkcs7BlockPadder struct {
+	size int
+}
+
+// NewPKCS7BlockPadder returns a padder which appends N bytes of value N to
+// the data, where N is between 1 and size, so that the data length is a
+// multiple of size. It panics if size is not between 1 and 255, as N must
+// fit into a byte.
+func NewPKCS7BlockPadder(size int) BlockPadder {
+	if size < 1 || size > 255 {
+		panic(fmt.Sprintf("block size %d should be between 1 and 255", size))
+	}
+
+	return &pkcs7BlockPadder{size: size}
+}
+
+func (p *pkcs7BlockPadder) Pad(data []byte) []byte {
+	n := p.size - len(data)%p.size
+
+	return append(data, bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+func (p *pkcs7BlockPadder) Unpad(data []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%p.size != 0 {
+		return nil, fmt.Errorf("data length %d is not a multiple of block size %d", len(data), p.size)
+	}
+
+	n := int(data[len(data)-1])
+	if n == 0 || n > p.size {
+		return nil, fmt.Errorf("invalid padding length %d", n)
+	}
+
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, fmt.Errorf("invalid padding byte 0x%02X", b)
+		}
+	}
+
+	return data[:len(data)-n], nil
+}
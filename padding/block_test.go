@@ -0,0 +1,67 @@
+package padding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestZeroBlockPadder(t *testing.T) {
+	padder := NewZeroBlockPadder(8)
+
+	t.Run("Pad", func(t *testing.T) {
+		require.Equal(t, []byte("12345\x00\x00\x00"), padder.Pad([]byte("12345")))
+		require.Equal(t, []byte("12345678"), padder.Pad([]byte("12345678")))
+	})
+
+	t.Run("Unpad", func(t *testing.T) {
+		got, err := padder.Unpad([]byte("12345\x00\x00\x00"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("12345"), got)
+
+		_, err = padder.Unpad([]byte("12345"))
+		require.EqualError(t, err, "data length 5 is not a multiple of block size 8")
+	})
+
+	t.Run("panics on invalid block size", func(t *testing.T) {
+		require.PanicsWithValue(t, "block size 0 should be at least 1", func() {
+			NewZeroBlockPadder(0)
+		})
+		require.Panics(t, func() {
+			ZeroBlock(-8)
+		})
+	})
+}
+
+func TestPKCS7BlockPadder(t *testing.T) {
+	padder := NewPKCS7BlockPadder(8)
+
+	t.Run("Pad", func(t *testing.T) {
+		require.Equal(t, []byte("12345\x03\x03\x03"), padder.Pad([]byte("12345")))
+		require.Equal(t, append([]byte("12345678"), []byte{8, 8, 8, 8, 8, 8, 8, 8}...), padder.Pad([]byte("12345678")))
+	})
+
+	t.Run("Unpad", func(t *testing.T) {
+		got, err := padder.Unpad([]byte("12345\x03\x03\x03"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("12345"), got)
+
+		_, err = padder.Unpad([]byte("1234567\x09"))
+		require.EqualError(t, err, "invalid padding length 9")
+
+		_, err = padder.Unpad([]byte("12345\x03\x02\x03"))
+		require.EqualError(t, err, "invalid padding byte 0x02")
+
+		_, err = padder.Unpad([]byte("12345"))
+		require.EqualError(t, err, "data length 5 is not a multiple of block size 8")
+	})
+
+	t.Run("panics on invalid block size", func(t *testing.T) {
+		require.PanicsWithValue(t, "block size 0 should be between 1 and 255", func() {
+			NewPKCS7BlockPadder(0)
+		})
+		require.PanicsWithValue(t, "block size 256 should be between 1 and 255", func() {
+			PKCS7Block(256)
+		})
+	})
+}
@@ -0,0 +1,90 @@
+package padding
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// LeftBytes returns a new padder which pads data on the left with a
+// multi-byte sequence
+var LeftBytes func(pad []byte) Padder = NewLeftBytesPadder
+
+// RightBytes returns a new padder which pads data on the right with a
+// multi-byte sequence
+var RightBytes func(pad []byte) Padder = NewRightBytesPadder
+
+var _ PadChecker = (*bytesPadder)(nil)
+
+type bytesPadder struct {
+	pad  []byte
+	left bool
+}
+
+// NewLeftBytesPadder takes a sequence of bytes (e.g. two EBCDIC bytes) and
+// returns a padder which repeats the whole sequence in front of the data to
+// reach the length. If the missing width is not a multiple of the sequence
+// length, CheckPad returns an error and Pad returns data unpadded. Unpad
+// strips whole leading copies of the sequence. It panics if the sequence is
+// empty.
+func NewLeftBytesPadder(pad []byte) Padder {
+	return newBytesPadder(pad, true)
+}
+
+// NewRightBytesPadder is like NewLeftBytesPadder, but it pads and unpads the
+// data on the right.
+func NewRightBytesPadder(pad []byte) Padder {
+	return newBytesPadder(pad, false)
+}
+
+func newBytesPadder(pad []byte, left bool) Padder {
+	if len(pad) == 0 {
+		panic("pad sequence should not be empty")
+	}
+
+	return &bytesPadder{
+		pad:  append([]byte{}, pad...),
+		left: left,
+	}
+}
+
+// CheckPad returns an error if the width missing to reach the length is not
+// a multiple of the pad sequence length.
+func (p *bytesPadder) CheckPad(data []byte, length int) error {
+	missing := length - len(data)
+	if missing > 0 && missing%len(p.pad) != 0 {
+		return fmt.Errorf("missing width %d is not a multiple of pad sequence length %d", missing, len(p.pad))
+	}
+	return nil
+}
+
+func (p *bytesPadder) Pad(data []byte, length int) []byte {
+	missing := length - len(data)
+	if missing <= 0 || missing%len(p.pad) != 0 {
+		return data
+	}
+
+	padding := bytes.Repeat(p.pad, missing/len(p.pad))
+	if p.left {
+		return append(padding, data...)
+	}
+
+	return append(append([]byte{}, data...), padding...)
+}
+
+func (p *bytesPadder) Unpad(data []byte) []byte {
+	if p.left {
+		for bytes.HasPrefix(data, p.pad) {
+			data = data[len(p.pad):]
+		}
+		return data
+	}
+
+	for bytes.HasSuffix(data, p.pad) {
+		data = data[:len(data)-len(p.pad)]
+	}
+	return data
+}
+
+func (p *bytesPadder) Inspect() []byte {
+	return p.pad
+}
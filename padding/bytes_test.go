@@ -0,0 +1,64 @@
+package padding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeftBytesPadder(t *testing.T) {
+	padder := NewLeftBytesPadder([]byte{0x40, 0x4B})
+
+	t.Run("Pad aligned width", func(t *testing.T) {
+		got := padder.Pad([]byte{0xF1, 0xF2}, 6)
+		require.Equal(t, []byte{0x40, 0x4B, 0x40, 0x4B, 0xF1, 0xF2}, got)
+	})
+
+	t.Run("CheckPad rejects misaligned width", func(t *testing.T) {
+		checker, ok := padder.(PadChecker)
+		require.True(t, ok)
+
+		require.NoError(t, checker.CheckPad([]byte{0xF1, 0xF2}, 6))
+		require.NoError(t, checker.CheckPad([]byte{0xF1, 0xF2, 0xF3}, 3))
+
+		err := checker.CheckPad([]byte{0xF1, 0xF2, 0xF3}, 6)
+		require.EqualError(t, err, "missing width 3 is not a multiple of pad sequence length 2")
+
+		// Pad itself can't fail and leaves data unpadded
+		got := padder.Pad([]byte{0xF1, 0xF2, 0xF3}, 6)
+		require.Equal(t, []byte{0xF1, 0xF2, 0xF3}, got)
+	})
+
+	t.Run("Unpad strips whole copies", func(t *testing.T) {
+		got := padder.Unpad([]byte{0x40, 0x4B, 0x40, 0x4B, 0x40, 0xF1})
+		require.Equal(t, []byte{0x40, 0xF1}, got)
+	})
+}
+
+func TestRightBytesPadder(t *testing.T) {
+	padder := NewRightBytesPadder([]byte("-="))
+
+	t.Run("Pad aligned width", func(t *testing.T) {
+		got := padder.Pad([]byte("AB"), 6)
+		require.Equal(t, []byte("AB-=-="), got)
+	})
+
+	t.Run("CheckPad rejects misaligned width", func(t *testing.T) {
+		checker, ok := padder.(PadChecker)
+		require.True(t, ok)
+
+		err := checker.CheckPad([]byte("ABC"), 6)
+		require.EqualError(t, err, "missing width 3 is not a multiple of pad sequence length 2")
+	})
+
+	t.Run("Unpad strips whole copies", func(t *testing.T) {
+		got := padder.Unpad([]byte("AB=-=-="))
+		require.Equal(t, []byte("AB="), got)
+	})
+
+	t.Run("panics on empty sequence", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewRightBytesPadder(nil)
+		})
+	})
+}
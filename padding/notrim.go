@@ -0,0 +1,39 @@
+package padding
+
+import "bytes"
+
+// LeftNoTrim returns a new encode-only padder which pads data on the left
+// but never removes the padding
+var LeftNoTrim func(pad byte) Padder = NewLeftNoTrimPadder
+
+type leftNoTrimPadder struct {
+	pad byte
+}
+
+// NewLeftNoTrimPadder takes the given byte and returns a padder which is
+// intentionally asymmetric: Pad prepends pad bytes to reach the length
+// (like Left), but Unpad returns the data unchanged, so the pad bytes
+// received on the wire are kept in the field value. It's useful for fields
+// where the padding is significant for the other party (e.g. audit data).
+// Note that a value packed back after unpacking is already of full length
+// and is not padded again.
+func NewLeftNoTrimPadder(pad byte) Padder {
+	return &leftNoTrimPadder{pad}
+}
+
+func (p *leftNoTrimPadder) Pad(data []byte, length int) []byte {
+	if len(data) >= length {
+		return data
+	}
+
+	padding := bytes.Repeat([]byte{p.pad}, length-len(data))
+	return append(padding, data...)
+}
+
+func (p *leftNoTrimPadder) Unpad(data []byte) []byte {
+	return data
+}
+
+func (p *leftNoTrimPadder) Inspect() []byte {
+	return []byte{p.pad}
+}
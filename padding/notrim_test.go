@@ -0,0 +1,27 @@
+package padding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeftNoTrimPadder(t *testing.T) {
+	padder := NewLeftNoTrimPadder(' ')
+
+	t.Run("Pad adds pad bytes", func(t *testing.T) {
+		got := padder.Pad([]byte("12345"), 8)
+
+		require.Equal(t, []byte("   12345"), got)
+	})
+
+	t.Run("Unpad keeps pad bytes", func(t *testing.T) {
+		got := padder.Unpad([]byte("   12345"))
+
+		require.Equal(t, []byte("   12345"), got)
+	})
+
+	t.Run("Inspect", func(t *testing.T) {
+		require.Equal(t, []byte(" "), padder.Inspect())
+	})
+}
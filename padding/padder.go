@@ -5,3 +5,10 @@ type Padder interface {
 	Unpad(data []byte) []byte
 	Inspect() []byte
 }
+
+// PadChecker is implemented by padders that can't pad data to any length
+// (e.g. padders of multi-byte sequences). Fields call CheckPad before
+// padding and fail to pack when it returns an error.
+type PadChecker interface {
+	CheckPad(data []byte, length int) error
+}
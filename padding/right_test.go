@@ -26,4 +26,29 @@ func TestRightPadder(t *testing.T) {
 
 		require.Equal(t, want, got)
 	})
+
+	t.Run("Pad exact fit", func(t *testing.T) {
+		got := padder.Pad([]byte("12345"), 5)
+
+		require.Equal(t, []byte("12345"), got)
+	})
+
+	t.Run("Pad over length", func(t *testing.T) {
+		got := padder.Pad([]byte("1234567"), 5)
+
+		require.Equal(t, []byte("1234567"), got)
+	})
+}
+
+func TestRightPadderSpaces(t *testing.T) {
+	padder := NewRightPadder(' ')
+
+	t.Run("Pad", func(t *testing.T) {
+		require.Equal(t, []byte("100   "), padder.Pad([]byte("100"), 6))
+	})
+
+	t.Run("Unpad", func(t *testing.T) {
+		require.Equal(t, []byte("100"), padder.Unpad([]byte("100   ")))
+		require.Equal(t, []byte("100100"), padder.Unpad([]byte("100100")))
+	})
 }
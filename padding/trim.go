@@ -0,0 +1,34 @@
+package padding
+
+// TrimRight returns a new decode-only padder which trims trailing pad bytes
+var TrimRight func(pad byte) Padder = NewTrimRightPadder
+
+type trimRightPadder struct {
+	pad byte
+}
+
+// NewTrimRightPadder takes the given byte (which can be non-printable, e.g.
+// 0xFF) and returns a padder which only removes trailing pad bytes when
+// field is unpacked. Pad leaves values untouched. It's useful for fields
+// that are padded by the other party, but are never produced by us.
+func NewTrimRightPadder(pad byte) Padder {
+	return &trimRightPadder{pad}
+}
+
+func (p *trimRightPadder) Pad(data []byte, length int) []byte {
+	return data
+}
+
+func (p *trimRightPadder) Unpad(data []byte) []byte {
+	// trim bytes (not runes) as pad may be a non-UTF-8 byte
+	end := len(data)
+	for end > 0 && data[end-1] == p.pad {
+		end--
+	}
+
+	return data[:end]
+}
+
+func (p *trimRightPadder) Inspect() []byte {
+	return []byte{p.pad}
+}
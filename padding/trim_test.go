@@ -0,0 +1,27 @@
+package padding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrimRightPadder(t *testing.T) {
+	padder := NewTrimRightPadder(0xFF)
+
+	t.Run("Pad leaves data untouched", func(t *testing.T) {
+		got := padder.Pad([]byte("12345"), 10)
+
+		require.Equal(t, []byte("12345"), got)
+	})
+
+	t.Run("Unpad trims trailing pad bytes", func(t *testing.T) {
+		got := padder.Unpad([]byte{'1', 0xFF, '2', 0xFE, 0xFF, 0xFF})
+
+		require.Equal(t, []byte{'1', 0xFF, '2', 0xFE}, got)
+	})
+
+	t.Run("Inspect", func(t *testing.T) {
+		require.Equal(t, []byte{0xFF}, padder.Inspect())
+	})
+}
@@ -11,11 +11,19 @@ type asciiVarPrefixer struct {
 }
 
 var ASCII = Prefixers{
-	Fixed: &asciiFixedPrefixer{},
-	L:     &asciiVarPrefixer{1},
-	LL:    &asciiVarPrefixer{2},
-	LLL:   &asciiVarPrefixer{3},
-	LLLL:  &asciiVarPrefixer{4},
+	Fixed:     &asciiFixedPrefixer{},
+	L:         NewASCIILength(1),
+	LL:        NewASCIILength(2),
+	LLL:       NewASCIILength(3),
+	LLLL:      NewASCIILength(4),
+	NewLength: NewASCIILength,
+}
+
+// NewASCIILength returns a prefixer that encodes the length of the field
+// as the given number of ASCII digits, e.g. 5 digits for fields of up to
+// 99999 bytes.
+func NewASCIILength(digits int) Prefixer {
+	return &asciiVarPrefixer{digits}
 }
 
 func (p *asciiVarPrefixer) EncodeLength(maxLen, dataLen int) ([]byte, error) {
@@ -37,16 +45,18 @@ func (p *asciiVarPrefixer) DecodeLength(maxLen int, data []byte) (int, int, erro
 		return 0, 0, fmt.Errorf("not enough data length: %d to read: %d byte digits", len(data), p.Digits)
 	}
 
+	// length should consist of digits only (Atoi accepts signs as well)
+	for _, b := range data[:p.Digits] {
+		if b < '0' || b > '9' {
+			return 0, 0, fmt.Errorf("invalid length: %q", data[:p.Digits])
+		}
+	}
+
 	dataLen, err := strconv.Atoi(string(data[:p.Digits]))
 	if err != nil {
 		return 0, 0, err
 	}
 
-	// length should be positive
-	if dataLen < 0 {
-		return 0, 0, fmt.Errorf("invalid length: %d", dataLen)
-	}
-
 	if dataLen > maxLen {
 		return 0, 0, fmt.Errorf("data length: %d is larger than maximum %d", dataLen, maxLen)
 	}
@@ -76,3 +86,6 @@ func (p *asciiFixedPrefixer) DecodeLength(fixLen int, data []byte) (int, int, er
 func (p *asciiFixedPrefixer) Inspect() string {
 	return "ASCII.Fixed"
 }
+
+// FixedLength marks the prefixer as a fixed length one.
+func (p *asciiFixedPrefixer) FixedLength() {}
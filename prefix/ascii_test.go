@@ -50,6 +50,8 @@ func TestAsciiVarPrefixer_LHelpers(t *testing.T) {
 		{ASCII.LLL, 3, 340, 2, []byte("002")},
 		{ASCII.LLL, 3, 340, 200, []byte("200")},
 		{ASCII.LLLL, 4, 9999, 1234, []byte("1234")},
+		{ASCII.NewLength(5), 5, 99999, 12345, []byte("12345")},
+		{ASCII.NewLength(6), 6, 999999, 42, []byte("000042")},
 	}
 
 	// test encoding
@@ -72,6 +74,22 @@ func TestAsciiVarPrefixer_LHelpers(t *testing.T) {
 	}
 }
 
+func TestAsciiVarPrefixer_DecodeLengthDigitsValidation(t *testing.T) {
+	pref := ASCII.NewLength(5)
+
+	_, _, err := pref.DecodeLength(99999, []byte("+1234"))
+	require.EqualError(t, err, `invalid length: "+1234"`)
+
+	_, _, err = pref.DecodeLength(99999, []byte("-0001"))
+	require.EqualError(t, err, `invalid length: "-0001"`)
+
+	_, _, err = pref.DecodeLength(99999, []byte("12a45"))
+	require.EqualError(t, err, `invalid length: "12a45"`)
+
+	_, _, err = pref.DecodeLength(100, []byte("00101"))
+	require.EqualError(t, err, "data length: 101 is larger than maximum 100")
+}
+
 func TestAsciiFixedPrefixer(t *testing.T) {
 	pref := asciiFixedPrefixer{}
 
@@ -14,11 +14,18 @@ type bcdVarPrefixer struct {
 }
 
 var BCD = Prefixers{
-	Fixed: &bcdFixedPrefixer{},
-	L:     &bcdVarPrefixer{1},
-	LL:    &bcdVarPrefixer{2},
-	LLL:   &bcdVarPrefixer{3},
-	LLLL:  &bcdVarPrefixer{4},
+	Fixed:     &bcdFixedPrefixer{},
+	L:         &bcdVarPrefixer{1},
+	LL:        &bcdVarPrefixer{2},
+	LLL:       &bcdVarPrefixer{3},
+	LLLL:      &bcdVarPrefixer{4},
+	NewLength: newBCDLength,
+}
+
+// newBCDLength returns a prefixer that encodes the length of the field
+// with the given number of digits.
+func newBCDLength(digits int) Prefixer {
+	return &bcdVarPrefixer{digits}
 }
 
 func (p *bcdVarPrefixer) EncodeLength(maxLen, dataLen int) ([]byte, error) {
@@ -85,3 +92,6 @@ func (p *bcdFixedPrefixer) DecodeLength(fixLen int, data []byte) (int, int, erro
 func (p *bcdFixedPrefixer) Inspect() string {
 	return "BCD.Fixed"
 }
+
+// FixedLength marks the prefixer as a fixed length one.
+func (p *bcdFixedPrefixer) FixedLength() {}
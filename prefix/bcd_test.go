@@ -37,6 +37,7 @@ func TestBCDVarPrefixer_LHelpers(t *testing.T) {
 		{BCD.LL, 1, 20, 12, []byte{0x12}},
 		{BCD.LLL, 2, 340, 2, []byte{0x00, 0x02}},
 		{BCD.LLL, 2, 340, 200, []byte{0x02, 0x00}},
+		{BCD.LLL, 2, 999, 999, []byte{0x09, 0x99}},
 		{BCD.LLLL, 2, 9999, 1234, []byte{0x12, 0x34}},
 	}
 
@@ -8,11 +8,18 @@ import (
 )
 
 var Binary = Prefixers{
-	Fixed: &binaryFixedPrefixer{},
-	L:     &binaryVarPrefixer{1},
-	LL:    &binaryVarPrefixer{2},
-	LLL:   &binaryVarPrefixer{3},
-	LLLL:  &binaryVarPrefixer{4},
+	Fixed:     &binaryFixedPrefixer{},
+	L:         &binaryVarPrefixer{1},
+	LL:        &binaryVarPrefixer{2},
+	LLL:       &binaryVarPrefixer{3},
+	LLLL:      &binaryVarPrefixer{4},
+	NewLength: newBinaryLength,
+}
+
+// newBinaryLength returns a prefixer that encodes the length of the field
+// with the given number of bytes.
+func newBinaryLength(digits int) Prefixer {
+	return &binaryVarPrefixer{digits}
 }
 
 type binaryFixedPrefixer struct {
@@ -34,6 +41,9 @@ func (p *binaryFixedPrefixer) Inspect() string {
 	return "Binary.Fixed"
 }
 
+// FixedLength marks the prefixer as a fixed length one.
+func (p *binaryFixedPrefixer) FixedLength() {}
+
 type binaryVarPrefixer struct {
 	Digits int
 }
@@ -98,6 +108,16 @@ func (p *binaryVarPrefixer) DecodeLength(maxLen int, data []byte) (int, int, err
 	// it take 4 bytes to encode (u)int32
 	uint32Size := 4
 
+	// bytes in front of the last 4 bytes can hold only zeros (NewLength
+	// prefixers may have more than 4 bytes)
+	if len(prefBytes) > uint32Size {
+		head := prefBytes[:len(prefBytes)-uint32Size]
+		if len(bytes.TrimLeft(head, "\x00")) != 0 {
+			return 0, 0, fmt.Errorf("decode length: length %X exceeds maximum uint32", prefBytes)
+		}
+		prefBytes = prefBytes[len(prefBytes)-uint32Size:]
+	}
+
 	// prepend with 0x00 if len of data is less than intSize (4 bytes)
 	if len(prefBytes) < uint32Size {
 		prefBytes = append(bytes.Repeat([]byte{0x00}, uint32Size-len(prefBytes)), prefBytes...)
@@ -165,6 +165,19 @@ func Test_binaryVarPrefixer_DecodeLength(t *testing.T) {
 			wantRead:    1,
 			wantErr:     false,
 		},
+		{
+			name: "success(LL)",
+			fields: fields{
+				Digits: 2,
+			},
+			args: args{
+				maxLen: 999,
+				data:   []byte{0x00, 0x05, 0xff},
+			},
+			wantDataLen: 5,
+			wantRead:    2,
+			wantErr:     false,
+		},
 		{
 			name: "success(LLL)",
 			fields: fields{
@@ -0,0 +1,88 @@
+package prefix
+
+import "fmt"
+
+var _ Prefixer = (*BitFieldPrefixer)(nil)
+
+// BitFieldPrefixer is a one byte prefixer that holds the field length in
+// lenBits bits of the byte starting at bit lenShift (counting from the least
+// significant bit). The other bits of the byte (e.g. a type nibble) are not
+// part of the length: they are ignored on decode and set from Bits on
+// encode.
+type BitFieldPrefixer struct {
+	lenBits  int
+	lenShift int
+	bits     byte
+}
+
+// NewBitFieldLength returns a prefixer extracting the length from lenBits
+// bits of the prefix byte shifted by lenShift, e.g. NewBitFieldLength(4, 4)
+// reads the length from the high nibble. It panics if the bits don't fit
+// into a byte.
+func NewBitFieldLength(lenBits, lenShift int) *BitFieldPrefixer {
+	if lenBits < 1 || lenShift < 0 || lenBits+lenShift > 8 {
+		panic(fmt.Sprintf("%d length bits shifted by %d do not fit into a byte", lenBits, lenShift))
+	}
+
+	return &BitFieldPrefixer{
+		lenBits:  lenBits,
+		lenShift: lenShift,
+	}
+}
+
+// WithBits returns a copy of the prefixer that sets the bits outside of the
+// length to the corresponding bits of b on encode.
+func (p *BitFieldPrefixer) WithBits(b byte) *BitFieldPrefixer {
+	res := *p
+	res.bits = b &^ p.mask()
+	return &res
+}
+
+// Bits returns the bits of the prefix byte in data that are not part of the
+// length.
+func (p *BitFieldPrefixer) Bits(data []byte) (byte, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("not enough data length: %d to read: 1 byte", len(data))
+	}
+
+	return data[0] &^ p.mask(), nil
+}
+
+func (p *BitFieldPrefixer) EncodeLength(maxLen, dataLen int) ([]byte, error) {
+	if dataLen > maxLen {
+		return nil, fmt.Errorf("field length: %d is larger than maximum: %d", dataLen, maxLen)
+	}
+
+	if dataLen > p.maxValue() {
+		return nil, fmt.Errorf("field length: %d does not fit into %d bits", dataLen, p.lenBits)
+	}
+
+	return []byte{p.bits | byte(dataLen<<p.lenShift)}, nil
+}
+
+func (p *BitFieldPrefixer) DecodeLength(maxLen int, data []byte) (int, int, error) {
+	if len(data) < 1 {
+		return 0, 0, fmt.Errorf("not enough data length: %d to read: 1 byte", len(data))
+	}
+
+	dataLen := int(data[0]&p.mask()) >> p.lenShift
+
+	if dataLen > maxLen {
+		return 0, 0, fmt.Errorf("data length: %d is larger than maximum %d", dataLen, maxLen)
+	}
+
+	return dataLen, 1, nil
+}
+
+// Inspect returns human readable information about length prefixer.
+func (p *BitFieldPrefixer) Inspect() string {
+	return fmt.Sprintf("BitField.L(%d<<%d)", p.lenBits, p.lenShift)
+}
+
+func (p *BitFieldPrefixer) maxValue() int {
+	return 1<<p.lenBits - 1
+}
+
+func (p *BitFieldPrefixer) mask() byte {
+	return byte(p.maxValue() << p.lenShift)
+}
@@ -0,0 +1,76 @@
+package prefix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitFieldPrefixer(t *testing.T) {
+	// length in the high nibble, type in the low nibble
+	pref := NewBitFieldLength(4, 4)
+
+	t.Run("DecodeLength reads high nibble", func(t *testing.T) {
+		length, read, err := pref.DecodeLength(15, []byte{0x5A, 0x01})
+		require.NoError(t, err)
+		require.Equal(t, 5, length)
+		require.Equal(t, 1, read)
+
+		_, _, err = pref.DecodeLength(4, []byte{0x5A})
+		require.EqualError(t, err, "data length: 5 is larger than maximum 4")
+
+		_, _, err = pref.DecodeLength(15, []byte{})
+		require.EqualError(t, err, "not enough data length: 0 to read: 1 byte")
+	})
+
+	t.Run("round trip preserves type bits", func(t *testing.T) {
+		data := []byte{0x5A}
+
+		length, _, err := pref.DecodeLength(15, data)
+		require.NoError(t, err)
+
+		bits, err := pref.Bits(data)
+		require.NoError(t, err)
+		require.Equal(t, byte(0x0A), bits)
+
+		res, err := pref.WithBits(bits).EncodeLength(15, length)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+
+		// the original prefixer is not changed
+		res, err = pref.EncodeLength(15, length)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x50}, res)
+	})
+
+	t.Run("EncodeLength returns error when length doesn't fit", func(t *testing.T) {
+		_, err := pref.EncodeLength(20, 16)
+		require.EqualError(t, err, "field length: 16 does not fit into 4 bits")
+
+		_, err = pref.EncodeLength(10, 11)
+		require.EqualError(t, err, "field length: 11 is larger than maximum: 10")
+	})
+
+	t.Run("low bits length", func(t *testing.T) {
+		pref := NewBitFieldLength(3, 0)
+
+		length, _, err := pref.DecodeLength(7, []byte{0xFE})
+		require.NoError(t, err)
+		require.Equal(t, 6, length)
+
+		res, err := pref.WithBits(0xF8).EncodeLength(7, 6)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xFE}, res)
+	})
+
+	t.Run("panics on invalid bits", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewBitFieldLength(4, 5)
+		})
+		require.Panics(t, func() {
+			NewBitFieldLength(0, 0)
+		})
+	})
+
+	require.Equal(t, "BitField.L(4<<4)", pref.Inspect())
+}
@@ -13,11 +13,18 @@ type ebcdicVarPrefixer struct {
 }
 
 var EBCDIC = Prefixers{
-	Fixed: &ebcdicFixedPrefixer{},
-	L:     &ebcdicVarPrefixer{1},
-	LL:    &ebcdicVarPrefixer{2},
-	LLL:   &ebcdicVarPrefixer{3},
-	LLLL:  &ebcdicVarPrefixer{4},
+	Fixed:     &ebcdicFixedPrefixer{},
+	L:         &ebcdicVarPrefixer{1},
+	LL:        &ebcdicVarPrefixer{2},
+	LLL:       &ebcdicVarPrefixer{3},
+	LLLL:      &ebcdicVarPrefixer{4},
+	NewLength: newEBCDICLength,
+}
+
+// newEBCDICLength returns a prefixer that encodes the length of the field
+// with the given number of digits.
+func newEBCDICLength(digits int) Prefixer {
+	return &ebcdicVarPrefixer{digits}
 }
 
 func (p *ebcdicVarPrefixer) EncodeLength(maxLen, dataLen int) ([]byte, error) {
@@ -84,3 +91,6 @@ func (p *ebcdicFixedPrefixer) DecodeLength(fixLen int, data []byte) (int, int, e
 func (p *ebcdicFixedPrefixer) Inspect() string {
 	return "EBCDIC.Fixed"
 }
+
+// FixedLength marks the prefixer as a fixed length one.
+func (p *ebcdicFixedPrefixer) FixedLength() {}
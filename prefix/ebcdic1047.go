@@ -9,11 +9,18 @@ import (
 )
 
 var EBCDIC1047 = Prefixers{
-	Fixed: &ebcdic1047FixedPrefixer{},
-	L:     &ebcdic1047Prefixer{1},
-	LL:    &ebcdic1047Prefixer{2},
-	LLL:   &ebcdic1047Prefixer{3},
-	LLLL:  &ebcdic1047Prefixer{4},
+	Fixed:     &ebcdic1047FixedPrefixer{},
+	L:         &ebcdic1047Prefixer{1},
+	LL:        &ebcdic1047Prefixer{2},
+	LLL:       &ebcdic1047Prefixer{3},
+	LLLL:      &ebcdic1047Prefixer{4},
+	NewLength: newEBCDIC1047Length,
+}
+
+// newEBCDIC1047Length returns a prefixer that encodes the length of the field
+// with the given number of digits.
+func newEBCDIC1047Length(digits int) Prefixer {
+	return &ebcdic1047Prefixer{digits}
 }
 
 type ebcdic1047Prefixer struct {
@@ -80,3 +87,6 @@ func (p *ebcdic1047FixedPrefixer) DecodeLength(fixLen int, data []byte) (int, in
 func (p *ebcdic1047FixedPrefixer) Inspect() string {
 	return "EBCDIC.Fixed"
 }
+
+// FixedLength marks the prefixer as a fixed length one.
+func (p *ebcdic1047FixedPrefixer) FixedLength() {}
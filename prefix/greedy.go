@@ -0,0 +1,33 @@
+package prefix
+
+import "fmt"
+
+// Greedy is a prefixer for the fields (usually the last subfield of a
+// composite) that consume all remaining data. It has no length header:
+// DecodeLength returns the length of the remaining data (but not more than
+// maxLen) and EncodeLength emits no bytes and only validates the maximum
+// length.
+var Greedy = &greedyPrefixer{}
+
+type greedyPrefixer struct{}
+
+func (p *greedyPrefixer) EncodeLength(maxLen, dataLen int) ([]byte, error) {
+	if dataLen > maxLen {
+		return nil, fmt.Errorf("field length: %d is larger than maximum: %d", dataLen, maxLen)
+	}
+
+	return []byte{}, nil
+}
+
+func (p *greedyPrefixer) DecodeLength(maxLen int, data []byte) (int, int, error) {
+	if len(data) > maxLen {
+		return maxLen, 0, nil
+	}
+
+	return len(data), 0, nil
+}
+
+// Inspect returns human readable information about length prefixer.
+func (p *greedyPrefixer) Inspect() string {
+	return "Greedy"
+}
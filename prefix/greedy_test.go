@@ -0,0 +1,32 @@
+package prefix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGreedyPrefixer(t *testing.T) {
+	t.Run("EncodeLength", func(t *testing.T) {
+		got, err := Greedy.EncodeLength(10, 5)
+		require.NoError(t, err)
+		require.Empty(t, got)
+
+		_, err = Greedy.EncodeLength(10, 11)
+		require.EqualError(t, err, "field length: 11 is larger than maximum: 10")
+	})
+
+	t.Run("DecodeLength returns length of remaining data", func(t *testing.T) {
+		length, read, err := Greedy.DecodeLength(10, []byte("12345"))
+		require.NoError(t, err)
+		require.Equal(t, 5, length)
+		require.Equal(t, 0, read)
+	})
+
+	t.Run("DecodeLength caps length at maximum", func(t *testing.T) {
+		length, read, err := Greedy.DecodeLength(3, []byte("12345"))
+		require.NoError(t, err)
+		require.Equal(t, 3, length)
+		require.Equal(t, 0, read)
+	})
+}
@@ -8,11 +8,18 @@ import (
 )
 
 var Hex = Prefixers{
-	Fixed: &hexFixedPrefixer{},
-	L:     &hexVarPrefixer{1},
-	LL:    &hexVarPrefixer{2},
-	LLL:   &hexVarPrefixer{3},
-	LLLL:  &hexVarPrefixer{4},
+	Fixed:     &hexFixedPrefixer{},
+	L:         &hexVarPrefixer{1},
+	LL:        &hexVarPrefixer{2},
+	LLL:       &hexVarPrefixer{3},
+	LLLL:      &hexVarPrefixer{4},
+	NewLength: newHexLength,
+}
+
+// newHexLength returns a prefixer that encodes the length of the field
+// with the given number of digits.
+func newHexLength(digits int) Prefixer {
+	return &hexVarPrefixer{digits}
 }
 
 type hexFixedPrefixer struct {
@@ -35,6 +42,9 @@ func (p *hexFixedPrefixer) Inspect() string {
 	return "Hex.Fixed"
 }
 
+// FixedLength marks the prefixer as a fixed length one.
+func (p *hexFixedPrefixer) FixedLength() {}
+
 type hexVarPrefixer struct {
 	Digits int
 }
@@ -61,6 +71,13 @@ func (p *hexVarPrefixer) DecodeLength(maxLen int, data []byte) (int, int, error)
 		return 0, 0, fmt.Errorf("length mismatch: want to read %d bytes, get only %d", length, len(data))
 	}
 
+	// length should consist of hex digits only (ParseInt accepts signs as well)
+	for _, b := range data[:length] {
+		if !isHexDigit(b) {
+			return 0, 0, fmt.Errorf("invalid length: %q", data[:length])
+		}
+	}
+
 	dataLen, err := strconv.ParseInt(string(data[:length]), 16, p.Digits*8)
 	if err != nil {
 		return 0, 0, err
@@ -76,3 +93,7 @@ func (p *hexVarPrefixer) DecodeLength(maxLen int, data []byte) (int, int, error)
 func (p *hexVarPrefixer) Inspect() string {
 	return fmt.Sprintf("Hex.%s", strings.Repeat("L", p.Digits))
 }
+
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'A' && b <= 'F') || (b >= 'a' && b <= 'f')
+}
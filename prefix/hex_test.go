@@ -168,3 +168,17 @@ func Test_hexVarPrefixer_DecodeLength(t *testing.T) {
 		})
 	}
 }
+
+func TestHexVarPrefixerRejectsNonHexLength(t *testing.T) {
+	// ParseInt alone would accept the sign and return a negative length
+	_, _, err := Hex.L.DecodeLength(16, []byte("-1whatever"))
+	require.EqualError(t, err, `invalid length: "-1"`)
+
+	_, _, err = Hex.LL.DecodeLength(16, []byte("00G1whatever"))
+	require.EqualError(t, err, `invalid length: "00G1"`)
+
+	dataLen, read, err := Hex.L.DecodeLength(16, []byte("0fwhatever"))
+	require.NoError(t, err)
+	require.Equal(t, 15, dataLen)
+	require.Equal(t, 2, read)
+}
@@ -18,3 +18,6 @@ func (p *nonePrefixer) DecodeLength(fixLen int, data []byte) (int, int, error) {
 func (p *nonePrefixer) Inspect() string {
 	return "None.Fixed"
 }
+
+// FixedLength marks the prefixer as a fixed length one.
+func (p *nonePrefixer) FixedLength() {}
@@ -18,12 +18,26 @@ type Prefixer interface {
 	Inspect() string
 }
 
+// FixedLengthPrefixer is implemented by the prefixers of fixed length
+// fields, which don't encode the length of the field.
+type FixedLengthPrefixer interface {
+	Prefixer
+
+	// FixedLength marks the prefixer as a fixed length one.
+	FixedLength()
+}
+
 type Prefixers struct {
 	Fixed Prefixer
 	L     Prefixer
 	LL    Prefixer
 	LLL   Prefixer
 	LLLL  Prefixer
+
+	// NewLength creates a prefixer with an arbitrary number of length
+	// digits (e.g. 5 for LLLLL). It's nil for the None family, which has
+	// only the fixed length prefixer.
+	NewLength PrefixerBuilder
 }
 
 type PrefixerBuilder func(int) Prefixer
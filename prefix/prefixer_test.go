@@ -0,0 +1,55 @@
+package prefix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixersNewLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefixers Prefixers
+		read      int
+	}{
+		{"ASCII", ASCII, 5},
+		{"BCD", BCD, 3},
+		{"Binary", Binary, 5},
+		{"EBCDIC", EBCDIC, 5},
+		{"EBCDIC1047", EBCDIC1047, 5},
+		{"Hex", Hex, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NotNil(t, tt.prefixers.NewLength)
+
+			pref := tt.prefixers.NewLength(5)
+
+			packed, err := pref.EncodeLength(99999, 12345)
+			require.NoError(t, err)
+			require.Len(t, packed, tt.read)
+
+			length, read, err := pref.DecodeLength(99999, packed)
+			require.NoError(t, err)
+			require.Equal(t, 12345, length)
+			require.Equal(t, tt.read, read)
+		})
+	}
+
+	t.Run("Binary length that doesn't fit into uint32", func(t *testing.T) {
+		_, _, err := Binary.NewLength(5).DecodeLength(99999, []byte{0x01, 0x00, 0x00, 0x30, 0x39})
+		require.EqualError(t, err, "decode length: length 0100003039 exceeds maximum uint32")
+	})
+}
+
+func TestFixedLengthPrefixer(t *testing.T) {
+	for _, pref := range []Prefixer{ASCII.Fixed, BCD.Fixed, Binary.Fixed, EBCDIC.Fixed, EBCDIC1047.Fixed, Hex.Fixed, None.Fixed} {
+		require.Implements(t, (*FixedLengthPrefixer)(nil), pref, pref.Inspect())
+	}
+
+	for _, pref := range []Prefixer{ASCII.LL, BCD.LL, Binary.LL, EBCDIC.LL, EBCDIC1047.LL, Hex.LL, Greedy, BerTLV} {
+		_, ok := pref.(FixedLengthPrefixer)
+		require.False(t, ok, pref.Inspect())
+	}
+}
@@ -50,3 +50,42 @@ func StringsByHex(x []string) {
 		return new(big.Int).SetBytes(valI).Int64() < new(big.Int).SetBytes(valJ).Int64()
 	})
 }
+
+// ByExplicitOrder returns a function which sorts a slice of strings placing
+// the strings listed in order first, in the given order. Unlisted strings
+// follow in the order of their integer value (as with StringsByInt), and
+// unlisted strings that are not integers come last in increasing order.
+func ByExplicitOrder(order []string) StringSlice {
+	positions := make(map[string]int, len(order))
+	for i, s := range order {
+		if _, found := positions[s]; !found {
+			positions[s] = i
+		}
+	}
+
+	return func(x []string) {
+		sort.SliceStable(x, func(i, j int) bool {
+			posI, listedI := positions[x[i]]
+			posJ, listedJ := positions[x[j]]
+
+			switch {
+			case listedI && listedJ:
+				return posI < posJ
+			case listedI != listedJ:
+				return listedI
+			}
+
+			valI, errI := strconv.Atoi(x[i])
+			valJ, errJ := strconv.Atoi(x[j])
+
+			switch {
+			case errI == nil && errJ == nil:
+				return valI < valJ
+			case (errI == nil) != (errJ == nil):
+				return errI == nil
+			}
+
+			return x[i] < x[j]
+		})
+	}
+}
@@ -23,3 +23,16 @@ func TestSortStringsByHex(t *testing.T) {
 	StringsByHex(x)
 	require.Equal(t, []string{"10", "B0", "ABCD"}, x)
 }
+
+func TestSortByExplicitOrder(t *testing.T) {
+	sortFn := ByExplicitOrder([]string{"DE", "2", "1"})
+
+	x := []string{"11", "1", "ZZ", "3", "DE", "AB", "2"}
+	sortFn(x)
+	require.Equal(t, []string{"DE", "2", "1", "3", "11", "AB", "ZZ"}, x)
+
+	// the result doesn't depend on the input order
+	y := []string{"AB", "2", "ZZ", "DE", "11", "3", "1"}
+	sortFn(y)
+	require.Equal(t, x, y)
+}
@@ -0,0 +1,146 @@
+package iso8583
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/field"
+	"github.com/moov-io/iso8583/padding"
+	"github.com/moov-io/iso8583/prefix"
+)
+
+// SpecCompatible checks whether messages packed using spec a can be
+// unpacked using spec b (and vice versa). Specs are compared field by
+// field, including subfields: field types, lengths, encoders, prefixers and
+// padders should match. Descriptions and names are ignored. It returns
+// false and the list of found incompatibilities if specs are not
+// compatible.
+func SpecCompatible(a, b *MessageSpec) (bool, []string) {
+	var issues []string
+
+	issues = append(issues, compareFields("envelope", a.Envelope, b.Envelope)...)
+
+	ids := map[int]struct{}{}
+	for id := range a.Fields {
+		ids[id] = struct{}{}
+	}
+	for id := range b.Fields {
+		ids[id] = struct{}{}
+	}
+
+	sortedIDs := make([]int, 0, len(ids))
+	for id := range ids {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Ints(sortedIDs)
+
+	for _, id := range sortedIDs {
+		issues = append(issues, compareFields(fmt.Sprintf("field %d", id), a.Fields[id], b.Fields[id])...)
+	}
+
+	return len(issues) == 0, issues
+}
+
+func compareFields(path string, a, b field.Field) []string {
+	if a == nil && b == nil {
+		return nil
+	}
+	if a == nil {
+		return []string{fmt.Sprintf("%s: defined only in the second spec", path)}
+	}
+	if b == nil {
+		return []string{fmt.Sprintf("%s: defined only in the first spec", path)}
+	}
+
+	typeA, typeB := reflect.TypeOf(a).Elem().Name(), reflect.TypeOf(b).Elem().Name()
+	if typeA != typeB {
+		return []string{fmt.Sprintf("%s: type %s does not match %s", path, typeA, typeB)}
+	}
+
+	return compareSpecs(path, a.Spec(), b.Spec())
+}
+
+func compareSpecs(path string, a, b *field.Spec) []string {
+	var issues []string
+
+	if a.Length != b.Length {
+		issues = append(issues, fmt.Sprintf("%s: length %d does not match %d", path, a.Length, b.Length))
+	}
+
+	if encA, encB := inspectEncoder(a.Enc), inspectEncoder(b.Enc); encA != encB {
+		issues = append(issues, fmt.Sprintf("%s: encoding %s does not match %s", path, encA, encB))
+	}
+
+	if prefA, prefB := inspectPrefixer(a.Pref), inspectPrefixer(b.Pref); prefA != prefB {
+		issues = append(issues, fmt.Sprintf("%s: prefix %s does not match %s", path, prefA, prefB))
+	}
+
+	if padA, padB := inspectPadder(a.Pad), inspectPadder(b.Pad); padA != padB {
+		issues = append(issues, fmt.Sprintf("%s: padding %s does not match %s", path, padA, padB))
+	}
+
+	if (a.Tag == nil) != (b.Tag == nil) {
+		issues = append(issues, fmt.Sprintf("%s: tag is defined only in one spec", path))
+	} else if a.Tag != nil {
+		if a.Tag.Length != b.Tag.Length {
+			issues = append(issues, fmt.Sprintf("%s: tag length %d does not match %d", path, a.Tag.Length, b.Tag.Length))
+		}
+		if encA, encB := inspectEncoder(a.Tag.Enc), inspectEncoder(b.Tag.Enc); encA != encB {
+			issues = append(issues, fmt.Sprintf("%s: tag encoding %s does not match %s", path, encA, encB))
+		}
+		if padA, padB := inspectPadder(a.Tag.Pad), inspectPadder(b.Tag.Pad); padA != padB {
+			issues = append(issues, fmt.Sprintf("%s: tag padding %s does not match %s", path, padA, padB))
+		}
+	}
+
+	if (a.Bitmap == nil) != (b.Bitmap == nil) {
+		issues = append(issues, fmt.Sprintf("%s: bitmap is defined only in one spec", path))
+	} else if a.Bitmap != nil {
+		issues = append(issues, compareSpecs(path+" bitmap", a.Bitmap.Spec(), b.Bitmap.Spec())...)
+	}
+
+	tags := map[string]struct{}{}
+	for tag := range a.Subfields {
+		tags[tag] = struct{}{}
+	}
+	for tag := range b.Subfields {
+		tags[tag] = struct{}{}
+	}
+
+	sortedTags := make([]string, 0, len(tags))
+	for tag := range tags {
+		sortedTags = append(sortedTags, tag)
+	}
+	sort.Strings(sortedTags)
+
+	for _, tag := range sortedTags {
+		issues = append(issues, compareFields(path+"."+tag, a.Subfields[tag], b.Subfields[tag])...)
+	}
+
+	return issues
+}
+
+func inspectEncoder(enc encoding.Encoder) string {
+	if enc == nil {
+		return "none"
+	}
+	return enc.Inspect()
+}
+
+func inspectPrefixer(pref prefix.Prefixer) string {
+	if pref == nil {
+		return "none"
+	}
+	return pref.Inspect()
+}
+
+// inspectPadder returns padder type along with its pad as padders don't
+// have a name.
+func inspectPadder(pad padding.Padder) string {
+	if pad == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%s(%q)", reflect.TypeOf(pad).Elem().Name(), pad.Inspect())
+}
@@ -0,0 +1,109 @@
+package iso8583
+
+import (
+	"testing"
+
+	"github.com/moov-io/iso8583/encoding"
+	"github.com/moov-io/iso8583/field"
+	"github.com/moov-io/iso8583/padding"
+	"github.com/moov-io/iso8583/prefix"
+	"github.com/moov-io/iso8583/sort"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecCompatible(t *testing.T) {
+	newSpec := func(panDescription string, panPref prefix.Prefixer) *MessageSpec {
+		return &MessageSpec{
+			Name: "Spec " + panDescription,
+			Fields: map[int]field.Field{
+				0: field.NewString(&field.Spec{
+					Length:      4,
+					Description: "Message Type Indicator",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+				}),
+				1: field.NewBitmap(&field.Spec{
+					Description: "Bitmap",
+					Enc:         encoding.BytesToASCIIHex,
+					Pref:        prefix.Hex.Fixed,
+				}),
+				2: field.NewString(&field.Spec{
+					Length:      19,
+					Description: panDescription,
+					Enc:         encoding.ASCII,
+					Pref:        panPref,
+				}),
+				4: field.NewNumeric(&field.Spec{
+					Length:      12,
+					Description: "Transaction Amount",
+					Enc:         encoding.ASCII,
+					Pref:        prefix.ASCII.Fixed,
+					Pad:         padding.Left('0'),
+				}),
+				48: field.NewComposite(&field.Spec{
+					Length:      999,
+					Description: "Additional Data",
+					Pref:        prefix.ASCII.LLL,
+					Tag: &field.TagSpec{
+						Length: 2,
+						Enc:    encoding.ASCII,
+						Sort:   sort.StringsByInt,
+					},
+					Subfields: map[string]field.Field{
+						"01": field.NewString(&field.Spec{
+							Length:      10,
+							Description: "Reference",
+							Enc:         encoding.ASCII,
+							Pref:        prefix.ASCII.LL,
+						}),
+					},
+				}),
+			},
+		}
+	}
+
+	t.Run("description change is compatible", func(t *testing.T) {
+		ok, issues := SpecCompatible(
+			newSpec("Primary Account Number", prefix.ASCII.LL),
+			newSpec("PAN", prefix.ASCII.LL),
+		)
+		require.True(t, ok)
+		require.Empty(t, issues)
+	})
+
+	t.Run("prefix change is incompatible", func(t *testing.T) {
+		ok, issues := SpecCompatible(
+			newSpec("Primary Account Number", prefix.ASCII.LL),
+			newSpec("Primary Account Number", prefix.BCD.LL),
+		)
+		require.False(t, ok)
+		require.Equal(t, []string{"field 2: prefix ASCII.LL does not match BCD.LL"}, issues)
+	})
+
+	t.Run("changes of fields and subfields are reported", func(t *testing.T) {
+		a := newSpec("Primary Account Number", prefix.ASCII.LL)
+		b := newSpec("Primary Account Number", prefix.ASCII.LL)
+
+		delete(b.Fields, 4)
+		b.Fields[3] = field.NewNumeric(&field.Spec{
+			Length:      6,
+			Description: "Processing Code",
+			Enc:         encoding.ASCII,
+			Pref:        prefix.ASCII.Fixed,
+		})
+		b.Fields[48].Spec().Subfields["01"] = field.NewNumeric(&field.Spec{
+			Length:      10,
+			Description: "Reference",
+			Enc:         encoding.ASCII,
+			Pref:        prefix.ASCII.LL,
+		})
+
+		ok, issues := SpecCompatible(a, b)
+		require.False(t, ok)
+		require.Equal(t, []string{
+			"field 3: defined only in the second spec",
+			"field 4: defined only in the first spec",
+			"field 48.01: type String does not match Numeric",
+		}, issues)
+	})
+}
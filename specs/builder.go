@@ -53,6 +53,7 @@ var (
 		"EBCDIC.LLLL":  prefix.EBCDIC.LLLL,
 		"Binary.Fixed": prefix.Binary.Fixed,
 		"BerTLV":       prefix.BerTLV,
+		"Greedy":       prefix.Greedy,
 	}
 
 	EncodingsExtToInt = map[string]encoding.Encoder{
@@ -67,13 +68,22 @@ var (
 	}
 
 	EncodingsIntToExt = map[string]string{
-		"asciiEncoder":      "ASCII",
-		"bcdEncoder":        "BCD",
-		"ebcdicEncoder":     "EBCDIC",
-		"binaryEncoder":     "Binary",
-		"hexToASCIIEncoder": "HexToASCII",
-		"asciiToHexEncoder": "ASCIIToHex",
-		"lBCDEncoder":       "LBCD",
+		"asciiEncoder":          "ASCII",
+		"bcdEncoder":            "BCD",
+		"ebcdicEncoder":         "EBCDIC",
+		"binaryEncoder":         "Binary",
+		"hexToASCIIEncoder":     "HexToASCII",
+		"asciiToHexEncoder":     "ASCIIToHex",
+		"lBCDEncoder":           "LBCD",
+		"ebcdic1047Encoder":     "EBCDIC1047",
+		"ebcdicTextEncoder":     "EBCDICText",
+		"asciiPrintableEncoder": "ASCIIPrintable",
+		"berTLVEncoderTag":      "BerTLVTag",
+		"base64Encoder":         "Base64",
+		"latin1Encoder":         "Latin1",
+		"shiftJISEncoder":       "ShiftJIS",
+		"validatedTextEncoder":  "TextValidated",
+		"runeTextEncoder":       "RuneText",
 	}
 
 	PaddersIntToExt = map[string]string{
@@ -161,7 +171,12 @@ func importField(dummyField *fieldDummy, index string) (*field.Spec, error) {
 	if len(dummyField.Subfields) == 0 {
 		fieldSpec.Enc = EncodingsExtToInt[dummyField.Enc]
 		if fieldSpec.Enc == nil {
-			return nil, fmt.Errorf("unknown encoding: %s for field: %s", dummyField.Enc, index)
+			// fall back to the encoders registered by name
+			enc, err := encoding.ByName(dummyField.Enc)
+			if err != nil {
+				return nil, fmt.Errorf("unknown encoding: %s for field: %s", dummyField.Enc, index)
+			}
+			fieldSpec.Enc = enc
 		}
 	} else {
 		fieldSpec.Subfields = map[string]field.Field{}
@@ -181,6 +196,13 @@ func importField(dummyField *fieldDummy, index string) (*field.Spec, error) {
 			Length: dummyField.Tag.Length,
 		}
 		fieldSpec.Tag.Enc = EncodingsExtToInt[dummyField.Tag.Enc]
+		if fieldSpec.Tag.Enc == nil && dummyField.Tag.Enc != "" {
+			enc, err := encoding.ByName(dummyField.Tag.Enc)
+			if err != nil {
+				return nil, fmt.Errorf("unknown tag encoding: %s for field: %s", dummyField.Tag.Enc, index)
+			}
+			fieldSpec.Tag.Enc = enc
+		}
 		if dummyField.Tag.Padding != nil {
 			if padderConstructor := PaddersExtToInt[dummyField.Tag.Padding.Type]; padderConstructor != nil {
 				fieldSpec.Tag.Pad = padderConstructor(dummyField.Tag.Padding.Pad)
@@ -318,6 +340,13 @@ func exportPad(pad padding.Padder) (*paddingDummy, error) {
 	return nil, fmt.Errorf("unknown padding type: %s", paddingType)
 }
 func exportEnc(enc encoding.Encoder) (string, error) {
+	// encoders of the same type may be registered as several variants
+	// (e.g. UTF16BE and UTF16LE, or HexToASCII and HexToASCIILower), so
+	// the registered name of the variant is preferred
+	if registered, err := encoding.ByName(enc.Inspect()); err == nil && reflect.DeepEqual(registered, enc) {
+		return enc.Inspect(), nil
+	}
+
 	// set encoding
 	encType := reflect.TypeOf(enc).Elem().Name()
 	if e, found := EncodingsIntToExt[encType]; found {
@@ -1,6 +1,7 @@
 package specs
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -114,3 +115,125 @@ func TestSpecWithCompositeFields(t *testing.T) {
 
 	require.Exactly(t, testSpec, importedSpec)
 }
+
+func TestImportJSONWithRegisteredEncoding(t *testing.T) {
+	specJSON := []byte(`{
+		"name": "Spec with registered encoding",
+		"fields": {
+			"0": {
+				"type": "String",
+				"length": 4,
+				"description": "Message Type Indicator",
+				"enc": "EBCDIC1047",
+				"prefix": "ASCII.Fixed"
+			}
+		}
+	}`)
+
+	spec, err := Builder.ImportJSON(specJSON)
+	require.NoError(t, err)
+	require.Equal(t, encoding.EBCDIC1047, spec.Fields[0].Spec().Enc)
+
+	specJSON = []byte(`{
+		"name": "Spec with unknown encoding",
+		"fields": {
+			"0": {
+				"type": "String",
+				"length": 4,
+				"description": "Message Type Indicator",
+				"enc": "Morse",
+				"prefix": "ASCII.Fixed"
+			}
+		}
+	}`)
+
+	_, err = Builder.ImportJSON(specJSON)
+	require.EqualError(t, err, "error importing field: 0. unknown encoding: Morse for field: 0")
+}
+
+func TestBuilderRegisteredEncodingsRoundTrip(t *testing.T) {
+	specJSON := []byte(`{
+		"name": "Spec with registered encodings",
+		"fields": {
+			"0": {
+				"type": "String",
+				"length": 4,
+				"description": "Message Type Indicator",
+				"enc": "Text",
+				"prefix": "ASCII.Fixed"
+			},
+			"2": {
+				"type": "String",
+				"length": 19,
+				"description": "Primary Account Number",
+				"enc": "Hex",
+				"prefix": "ASCII.LL"
+			},
+			"43": {
+				"type": "String",
+				"length": 40,
+				"description": "Card Acceptor Name/Location",
+				"enc": "UTF16LE",
+				"prefix": "ASCII.Fixed"
+			},
+			"48": {
+				"type": "Composite",
+				"length": 999,
+				"description": "Additional Data",
+				"prefix": "ASCII.LLL",
+				"tag": {
+					"length": 2,
+					"enc": "HexLower",
+					"sort": "StringsByHex"
+				},
+				"subfields": {
+					"01": {
+						"type": "String",
+						"length": 10,
+						"description": "Display Name",
+						"enc": "RuneText",
+						"prefix": "ASCII.LL"
+					}
+				}
+			}
+		}
+	}`)
+
+	spec, err := Builder.ImportJSON(specJSON)
+	require.NoError(t, err)
+	require.Equal(t, encoding.NewTextValidated(), spec.Fields[0].Spec().Enc)
+	require.Equal(t, encoding.BytesToASCIIHex, spec.Fields[2].Spec().Enc)
+	require.Equal(t, encoding.UTF16LE, spec.Fields[43].Spec().Enc)
+	require.Equal(t, encoding.NewHex(false), spec.Fields[48].Spec().Tag.Enc)
+	require.Equal(t, encoding.NewRuneText(), spec.Fields[48].Spec().Subfields["01"].Spec().Enc)
+
+	// encoders are exported by their full names
+	exported, err := Builder.ExportJSON(spec)
+	require.NoError(t, err)
+
+	var fields struct {
+		Fields map[string]struct {
+			Enc string `json:"enc"`
+			Tag struct {
+				Enc string `json:"enc"`
+			} `json:"tag"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(exported, &fields))
+	require.Equal(t, "TextValidated", fields.Fields["0"].Enc)
+	require.Equal(t, "HexToASCII", fields.Fields["2"].Enc)
+	require.Equal(t, "UTF16LE", fields.Fields["43"].Enc)
+	require.Equal(t, "HexToASCIILower", fields.Fields["48"].Tag.Enc)
+
+	imported, err := Builder.ImportJSON(exported)
+	require.NoError(t, err)
+
+	reexported, err := Builder.ExportJSON(imported)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	// We can't compare sort functions for equality, so nil them out to check the rest
+	spec.Fields[48].Spec().Tag.Sort = nil
+	imported.Fields[48].Spec().Tag.Sort = nil
+	require.Exactly(t, spec, imported)
+}